@@ -36,7 +36,7 @@ type AAM struct {
 
 // newAAM constructor
 func newAAM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeAAM)
 	return AAM{
 		BaseSentence:               s,
@@ -47,3 +47,14 @@ func newAAM(s BaseSentence) (Sentence, error) {
 		DestinationWaypointID:      p.String(4, "destination waypoint ID"),
 	}, p.Err()
 }
+
+// EncodeFields returns the AAM sentence fields in wire format
+func (s AAM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.StatusArrivalCircleEntered)
+	e.String(s.StatusPerpendicularPassed)
+	e.Float64(s.ArrivalCircleRadius, 3)
+	e.String(s.ArrivalCircleRadiusUnit)
+	e.String(s.DestinationWaypointID)
+	return e.Fields()
+}
@@ -42,7 +42,7 @@ type ABM struct {
 
 // newABM constructor
 func newABM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeABM)
 	return ABM{
 		BaseSentence:     s,
@@ -55,3 +55,16 @@ func newABM(s BaseSentence) (Sentence, error) {
 		Payload:          p.SixBitASCIIArmour(6, int(p.Int64(7, "number of padding bits")), "payload"),
 	}, p.Err()
 }
+
+// EncodeFields returns the ABM sentence fields in wire format
+func (s ABM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.NumFragments)
+	e.Int64(s.FragmentNumber)
+	e.Int64(s.MessageID)
+	e.String(s.MMSI)
+	e.String(s.Channel)
+	e.Int64(s.VDLMessageNumber)
+	e.SixBitASCIIArmour(s.Payload, "payload")
+	return e.Fields()
+}
@@ -21,10 +21,17 @@ type ACK struct {
 
 // newACKN constructor
 func newACK(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeACK)
 	return ACK{
 		BaseSentence:    s,
 		AlertIdentifier: p.Int64(0, "alert identifier"),
 	}, p.Err()
 }
+
+// EncodeFields returns the ACK sentence fields in wire format
+func (s ACK) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.AlertIdentifier)
+	return e.Fields()
+}
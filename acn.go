@@ -41,7 +41,7 @@ type ACN struct {
 
 // newACN constructor
 func newACN(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeACN)
 	return ACN{
 		BaseSentence:             s,
@@ -53,3 +53,15 @@ func newACN(s BaseSentence) (Sentence, error) {
 		State:                    p.String(5, "alarm state"),
 	}, p.Err()
 }
+
+// EncodeFields returns the ACN sentence fields in wire format
+func (s ACN) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.ManufacturerMnemonicCode)
+	e.Int64(s.AlertIdentifier)
+	e.Int64(s.AlertInstance)
+	e.String(s.Command)
+	e.String(s.State)
+	return e.Fields()
+}
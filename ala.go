@@ -52,7 +52,7 @@ type ALA struct {
 
 // newALA constructor
 func newALA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeALA)
 	return ALA{
 		BaseSentence:       s,
@@ -66,3 +66,17 @@ func newALA(s BaseSentence) (Sentence, error) {
 		Message:            p.String(7, "message"),
 	}, p.Err()
 }
+
+// EncodeFields returns the ALA sentence fields in wire format
+func (s ALA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.SystemIndicator)
+	e.String(s.SubSystemIndicator)
+	e.Int64(s.InstanceNumber)
+	e.Int64(s.Type)
+	e.String(s.Condition)
+	e.String(s.AlarmAckState)
+	e.String(s.Message)
+	return e.Fields()
+}
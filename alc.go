@@ -49,7 +49,7 @@ type ALCAlertEntry struct {
 
 // newALC constructor
 func newALC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeALC)
 	alc := ALC{
 		BaseSentence:   s,
@@ -80,3 +80,19 @@ func newALC(s BaseSentence) (Sentence, error) {
 
 	return alc, p.Err()
 }
+
+// EncodeFields returns the ALC sentence fields in wire format
+func (s ALC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.NumFragments)
+	e.Int64(s.FragmentNumber)
+	e.Int64(s.MessageID)
+	e.Int64(s.EntriesNumber)
+	for _, entry := range s.AlertEntries {
+		e.String(entry.ManufacturerMnemonicCode)
+		e.Int64(entry.AlertIdentifier)
+		e.Int64(entry.AlertInstance)
+		e.Int64(entry.RevisionCounter)
+	}
+	return e.Fields()
+}
@@ -71,7 +71,7 @@ type ALF struct {
 
 // newALF constructor
 func newALF(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeALF)
 	return ALF{
 		BaseSentence:             s,
@@ -90,3 +90,22 @@ func newALF(s BaseSentence) (Sentence, error) {
 		Text:                     p.String(12, "alert text"),
 	}, p.Err()
 }
+
+// EncodeFields returns the ALF sentence fields in wire format
+func (s ALF) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.NumFragments)
+	e.Int64(s.FragmentNumber)
+	e.Int64(s.MessageID)
+	e.Time(s.Time, "time")
+	e.String(s.Category)
+	e.String(s.Priority)
+	e.String(s.State)
+	e.String(s.ManufacturerMnemonicCode)
+	e.Int64(s.AlertIdentifier)
+	e.Int64(s.AlertInstance)
+	e.Int64(s.RevisionCounter)
+	e.Int64(s.EscalationCounter)
+	e.String(s.Text)
+	return e.Fields()
+}
@@ -35,7 +35,7 @@ type ALR struct {
 
 // newALR constructor
 func newALR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeALR)
 	return ALR{
 		BaseSentence:    s,
@@ -46,3 +46,14 @@ func newALR(s BaseSentence) (Sentence, error) {
 		Description:     p.String(4, "description"),
 	}, p.Err()
 }
+
+// EncodeFields returns the ALR sentence fields in wire format
+func (s ALR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.Int64(s.AlarmIdentifier)
+	e.String(s.Condition)
+	e.String(s.State)
+	e.String(s.Description)
+	return e.Fields()
+}
@@ -105,7 +105,7 @@ type APB struct {
 
 // newAPB constructor
 func newAPB(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeAPB)
 	apb := APB{
 		BaseSentence:               s,
@@ -129,3 +129,26 @@ func newAPB(s BaseSentence) (Sentence, error) {
 	}
 	return apb, p.Err()
 }
+
+// EncodeFields returns the APB sentence fields in wire format
+func (s APB) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.StatusGeneralWarning)
+	e.String(s.StatusLockWarning)
+	e.Float64(s.CrossTrackErrorMagnitude, 3)
+	e.String(s.DirectionToSteer)
+	e.String(s.CrossTrackUnits)
+	e.String(s.StatusArrivalCircleEntered)
+	e.String(s.StatusPerpendicularPassed)
+	e.Float64(s.BearingOriginToDest, 3)
+	e.String(s.BearingOriginToDestType)
+	e.String(s.DestinationWaypointID)
+	e.Float64(s.BearingPresentToDest, 3)
+	e.String(s.BearingPresentToDestType)
+	e.Float64(s.Heading, 3)
+	e.String(s.HeadingType)
+	if len(s.Fields) > 14 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
@@ -46,7 +46,7 @@ type ARC struct {
 
 // newARC constructor
 func newARC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeARC)
 	return ARC{
 		BaseSentence:             s,
@@ -57,3 +57,14 @@ func newARC(s BaseSentence) (Sentence, error) {
 		Command:                  p.EnumString(4, "refused alert command", AlertCommandAcknowledge, AlertCommandRequestRepeatInformation, AlertCommandResponsibilityTransfer, AlertCommandSilence),
 	}, p.Err()
 }
+
+// EncodeFields returns the ARC sentence fields in wire format
+func (s ARC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.ManufacturerMnemonicCode)
+	e.Int64(s.AlertIdentifier)
+	e.Int64(s.AlertInstance)
+	e.String(s.Command)
+	return e.Fields()
+}
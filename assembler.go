@@ -0,0 +1,311 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultAssemblerTimeout is the time an incomplete sentence sequence is kept by Assembler when Assembler.Timeout
+// is not set.
+const DefaultAssemblerTimeout = 10 * time.Second
+
+// maxSequenceLength is the maximum number of sentences in sequence (sequence numbers are 2 digits)
+const maxSequenceLength = 99
+
+// AssembledMessage is a logical message combined from a sequence of sentences. Concrete types are GSVMessage,
+// RTEMessage, TXTMessage, ALFMessage, ALCMessage and DSCMessage.
+type AssembledMessage interface {
+	// TalkerID returns talker ID of the sentences
+	TalkerID() string
+	// DataType returns the type of the sentences (type of first sentence for DSC+DSE)
+	DataType() string
+	// Sentences returns the sentences message was combined from in sequence order
+	Sentences() []Sentence
+}
+
+// sentenceSequence contains the sentences an assembled message was combined from. It is embedded in the message
+// types and implements the AssembledMessage methods.
+type sentenceSequence struct {
+	sentences []Sentence
+}
+
+// TalkerID returns talker ID of the sentences
+func (s sentenceSequence) TalkerID() string { return s.sentences[0].TalkerID() }
+
+// DataType returns the type of the first sentence
+func (s sentenceSequence) DataType() string { return s.sentences[0].DataType() }
+
+// Sentences returns the sentences message was combined from in sequence order
+func (s sentenceSequence) Sentences() []Sentence {
+	return append([]Sentence(nil), s.sentences...)
+}
+
+// GSVMessage is the full list of satellites in view combined from GSV sentences
+type GSVMessage struct {
+	sentenceSequence
+	Parts           []GSV
+	NumberSVsInView int64     // Total number of SVs in view
+	Info            []GSVInfo // visible satellite info from all sentences
+	SignalID        int64     // GNSS signal ID (NMEA 4.10+)
+	// SystemID has the same value as SignalID.
+	//
+	// Deprecated: use SignalID
+	SystemID int64
+}
+
+// RTEMessage is the full waypoint list of a route combined from RTE sentences
+type RTEMessage struct {
+	sentenceSequence
+	Parts                     []RTE
+	ActiveRouteOrWaypointList string   // Current active route or waypoint list
+	Name                      string   // Name or number of active route
+	Idents                    []string // List of ident of waypoints from all sentences
+}
+
+// TXTMessage is the complete text message combined from TXT sentences
+type TXTMessage struct {
+	sentenceSequence
+	Parts   []TXT
+	ID      int64  // identifier of the text message
+	Message string // text from all sentences
+}
+
+// ALFMessage is the alert combined from ALF sentences. Alert fields are in the first sentence, the second sentence
+// carries additional alert text.
+type ALFMessage struct {
+	sentenceSequence
+	Parts     []ALF
+	MessageID int64  // sequential message identifier
+	Text      string // alert text from all sentences
+}
+
+// ALCMessage is the cyclic alert list combined from ALC sentences
+type ALCMessage struct {
+	sentenceSequence
+	Parts        []ALC
+	MessageID    int64           // sequential message identifier
+	AlertEntries []ALCAlertEntry // alert entries from all sentences
+}
+
+// DSCMessage is the DSC sentence combined with its expansion DSE sentences. Expansion is empty when DSC sentence
+// does not have expansion indicator set.
+type DSCMessage struct {
+	sentenceSequence
+	DSC       DSC
+	Expansion []DSE
+	DataSets  []DSEDataSet // data sets from all DSE sentences
+}
+
+// Assembler combines sequences of sentences (GSV, RTE, TXT, ALF, ALC and DSC followed by DSE) into complete logical
+// messages. Parts of a sequence are buffered by talker ID, sentence type and message ID (system ID for GSV, route
+// name for RTE, MMSI for DSE) and can arrive in any order. Incomplete sequences are discarded after Timeout.
+//
+// Assembler is not safe for concurrent use by multiple goroutines.
+type Assembler struct {
+	// Timeout is the time incomplete sequence is kept since its first received sentence. DefaultAssemblerTimeout is
+	// used when zero.
+	Timeout time.Duration
+	// Now returns current time, time.Now is used when nil
+	Now func() time.Time
+
+	groups map[assemblerKey]*assemblerGroup
+	dsc    map[string]pendingDSC
+}
+
+type assemblerKey struct {
+	talker       string
+	sentenceType string
+	id           string
+}
+
+type assemblerGroup struct {
+	started  time.Time
+	total    int64
+	received int
+	parts    []Sentence
+}
+
+type pendingDSC struct {
+	received time.Time
+	dsc      DSC
+}
+
+// Add adds sentence to assembler. Combined message is returned when the sentence completes a sequence. Sentences
+// that are not part of any supported sequence are ignored and nil is returned. Error is returned for sentence with
+// invalid sequence numbers, the sequence it belongs to is discarded.
+func (a *Assembler) Add(s Sentence) (AssembledMessage, error) {
+	now := a.now()
+	a.expire(now)
+
+	if dsc, ok := s.(DSC); ok {
+		if strings.TrimSpace(dsc.ExpansionIndicator) != "E" {
+			return DSCMessage{sentenceSequence: sentenceSequence{sentences: []Sentence{dsc}}, DSC: dsc}, nil
+		}
+		if a.dsc == nil {
+			a.dsc = map[string]pendingDSC{}
+		}
+		a.dsc[dsc.Talker] = pendingDSC{received: now, dsc: dsc}
+		return nil, nil
+	}
+
+	id, total, number, ok := sequenceOf(s)
+	if !ok {
+		return nil, nil
+	}
+	key := assemblerKey{talker: s.TalkerID(), sentenceType: s.DataType(), id: id}
+	if total < 1 || total > maxSequenceLength || number < 1 || number > total {
+		delete(a.groups, key)
+		return nil, fmt.Errorf("nmea: %s invalid sequence number %d of %d", s.Prefix(), number, total)
+	}
+
+	g, ok := a.groups[key]
+	if ok && (g.total != total || g.parts[number-1] != nil) {
+		// repeated part or different total means that a new sequence has started
+		ok = false
+	}
+	if !ok {
+		g = &assemblerGroup{started: now, total: total, parts: make([]Sentence, total)}
+		if a.groups == nil {
+			a.groups = map[assemblerKey]*assemblerGroup{}
+		}
+		a.groups[key] = g
+	}
+	g.parts[number-1] = s
+	g.received++
+	if int64(g.received) < g.total {
+		return nil, nil
+	}
+	delete(a.groups, key)
+	return a.combine(g.parts)
+}
+
+// Pending returns the number of incomplete sequences (including DSC sentences waiting for DSE expansion)
+func (a *Assembler) Pending() int {
+	a.expire(a.now())
+	return len(a.groups) + len(a.dsc)
+}
+
+// Reset discards all incomplete sequences
+func (a *Assembler) Reset() {
+	a.groups = nil
+	a.dsc = nil
+}
+
+func (a *Assembler) now() time.Time {
+	if a.Now != nil {
+		return a.Now()
+	}
+	return time.Now()
+}
+
+// expire discards sequences that have timed out
+func (a *Assembler) expire(now time.Time) {
+	timeout := a.Timeout
+	if timeout == 0 {
+		timeout = DefaultAssemblerTimeout
+	}
+	for k, g := range a.groups {
+		if now.Sub(g.started) > timeout {
+			delete(a.groups, k)
+		}
+	}
+	for k, d := range a.dsc {
+		if now.Sub(d.received) > timeout {
+			delete(a.dsc, k)
+		}
+	}
+}
+
+// sequenceOf returns message ID, total number of sentences and sentence number of the sequence sentence
+func sequenceOf(s Sentence) (string, int64, int64, bool) {
+	switch m := s.(type) {
+	case GSV:
+		return strconv.FormatInt(m.SignalID, 10), m.TotalMessages, m.MessageNumber, true
+	case RTE:
+		return m.Name, m.NumberOfSentences, m.SentenceNumber, true
+	case TXT:
+		return strconv.FormatInt(m.ID, 10), m.TotalNumber, m.Number, true
+	case ALF:
+		return strconv.FormatInt(m.MessageID, 10), m.NumFragments, m.FragmentNumber, true
+	case ALC:
+		return strconv.FormatInt(m.MessageID, 10), m.NumFragments, m.FragmentNumber, true
+	case DSE:
+		return m.MMSI, m.TotalNumber, m.Number, true
+	}
+	return "", 0, 0, false
+}
+
+// combine creates message from complete sequence of sentences
+func (a *Assembler) combine(parts []Sentence) (AssembledMessage, error) {
+	sequence := sentenceSequence{sentences: parts}
+	switch parts[0].(type) {
+	case GSV:
+		m := GSVMessage{sentenceSequence: sequence}
+		for _, p := range parts {
+			gsv := p.(GSV)
+			m.Parts = append(m.Parts, gsv)
+			m.Info = append(m.Info, gsv.Info...)
+		}
+		m.NumberSVsInView = m.Parts[0].NumberSVsInView
+		m.SignalID = m.Parts[0].SignalID
+		m.SystemID = m.SignalID
+		return m, nil
+	case RTE:
+		m := RTEMessage{sentenceSequence: sequence}
+		for _, p := range parts {
+			rte := p.(RTE)
+			m.Parts = append(m.Parts, rte)
+			m.Idents = append(m.Idents, rte.Idents...)
+		}
+		m.ActiveRouteOrWaypointList = m.Parts[0].ActiveRouteOrWaypointList
+		m.Name = m.Parts[0].Name
+		return m, nil
+	case TXT:
+		m := TXTMessage{sentenceSequence: sequence}
+		for _, p := range parts {
+			txt := p.(TXT)
+			m.Parts = append(m.Parts, txt)
+			m.Message += txt.Message
+		}
+		m.ID = m.Parts[0].ID
+		return m, nil
+	case ALF:
+		m := ALFMessage{sentenceSequence: sequence}
+		for _, p := range parts {
+			alf := p.(ALF)
+			m.Parts = append(m.Parts, alf)
+			m.Text += alf.Text
+		}
+		m.MessageID = m.Parts[0].MessageID
+		return m, nil
+	case ALC:
+		m := ALCMessage{sentenceSequence: sequence}
+		for _, p := range parts {
+			alc := p.(ALC)
+			m.Parts = append(m.Parts, alc)
+			m.AlertEntries = append(m.AlertEntries, alc.AlertEntries...)
+		}
+		m.MessageID = m.Parts[0].MessageID
+		return m, nil
+	case DSE:
+		first := parts[0].(DSE)
+		pending, ok := a.dsc[first.Talker]
+		if !ok || (pending.dsc.Address != first.MMSI && pending.dsc.MMSI != first.MMSI) {
+			return nil, fmt.Errorf("nmea: %s expansion for MMSI %s without preceding DSC sentence", first.Prefix(), first.MMSI)
+		}
+		delete(a.dsc, first.Talker)
+		m := DSCMessage{
+			sentenceSequence: sentenceSequence{sentences: append([]Sentence{pending.dsc}, parts...)},
+			DSC:              pending.dsc,
+		}
+		for _, p := range parts {
+			dse := p.(DSE)
+			m.Expansion = append(m.Expansion, dse)
+			m.DataSets = append(m.DataSets, dse.DataSets...)
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("nmea: %s sentences can not be combined", parts[0].Prefix())
+}
@@ -0,0 +1,220 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(t *testing.T, raw string) Sentence {
+	s, err := Parse(raw)
+	assert.NoError(t, err)
+	return s
+}
+
+func TestAssembler_Add(t *testing.T) {
+	var tests = []struct {
+		name   string
+		raws   []string
+		expect func(t *testing.T, m AssembledMessage)
+	}{
+		{
+			name: "GSV",
+			raws: []string{
+				"$GPGSV,2,1,05,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*73",
+				"$GPGSV,2,2,05,14,25,170,35*4E",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				gsv := m.(GSVMessage)
+				assert.Equal(t, int64(5), gsv.NumberSVsInView)
+				assert.Len(t, gsv.Info, 5)
+				assert.Equal(t, int64(14), gsv.Info[4].SVPRNNumber)
+			},
+		},
+		{
+			name: "GSV out of order",
+			raws: []string{
+				"$GPGSV,2,2,05,14,25,170,35*4E",
+				"$GPGSV,2,1,05,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*73",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				gsv := m.(GSVMessage)
+				assert.Equal(t, int64(3), gsv.Info[0].SVPRNNumber)
+				assert.Equal(t, int64(14), gsv.Info[4].SVPRNNumber)
+			},
+		},
+		{
+			name: "RTE",
+			raws: []string{
+				"$IIRTE,2,1,c,Rte 1,411,412*72",
+				"$IIRTE,2,2,c,Rte 1,413*68",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				rte := m.(RTEMessage)
+				assert.Equal(t, "Rte 1", rte.Name)
+				assert.Equal(t, "c", rte.ActiveRouteOrWaypointList)
+				assert.Equal(t, []string{"411", "412", "413"}, rte.Idents)
+			},
+		},
+		{
+			name: "TXT",
+			raws: []string{
+				"$GNTXT,02,01,07,hello *17",
+				"$GNTXT,02,02,07,world*34",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				txt := m.(TXTMessage)
+				assert.Equal(t, int64(7), txt.ID)
+				assert.Equal(t, "hello world", txt.Message)
+			},
+		},
+		{
+			name: "single TXT",
+			raws: []string{"$GNTXT,01,01,02,u-blox AG - www.u-blox.com*4E"},
+			expect: func(t *testing.T, m AssembledMessage) {
+				assert.Equal(t, "u-blox AG - www.u-blox.com", m.(TXTMessage).Message)
+			},
+		},
+		{
+			name: "ALF",
+			raws: []string{
+				"$VDALF,2,1,3,220516,B,A,S,SAL,001,1,2,0,Engine*65",
+				"$VDALF,2,2,3,,,,,,,,,,overheat*50",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				alf := m.(ALFMessage)
+				assert.Equal(t, int64(3), alf.MessageID)
+				assert.Equal(t, "Engineoverheat", alf.Text)
+				assert.Equal(t, "SAL", alf.Parts[0].ManufacturerMnemonicCode)
+			},
+		},
+		{
+			name: "ALC",
+			raws: []string{
+				"$FBALC,02,01,03,01,FEB,01,02,03*0A",
+				"$FBALC,02,02,03,01,TEB,02,03,04*1E",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				alc := m.(ALCMessage)
+				assert.Equal(t, int64(3), alc.MessageID)
+				assert.Equal(t, []ALCAlertEntry{
+					{ManufacturerMnemonicCode: "FEB", AlertIdentifier: 1, AlertInstance: 2, RevisionCounter: 3},
+					{ManufacturerMnemonicCode: "TEB", AlertIdentifier: 2, AlertInstance: 3, RevisionCounter: 4},
+				}, alc.AlertEntries)
+			},
+		},
+		{
+			name: "DSC with expansion",
+			raws: []string{
+				"$CDDSC,12,3380400790,12,06,00,1423108312,2019,,,S,E*6A",
+				"$CDDSE,2,1,A,3380400790,00,46504437*16",
+				"$CDDSE,2,2,A,3380400790,01,16501437*14",
+			},
+			expect: func(t *testing.T, m AssembledMessage) {
+				dsc := m.(DSCMessage)
+				assert.Equal(t, "3380400790", dsc.DSC.Address)
+				assert.Len(t, dsc.Expansion, 2)
+				assert.Equal(t, []DSEDataSet{{Code: "00", Data: "46504437"}, {Code: "01", Data: "16501437"}}, dsc.DataSets)
+				assert.Len(t, dsc.Sentences(), 3)
+			},
+		},
+		{
+			name: "DSC without expansion",
+			raws: []string{"$CDDSC,12,3381581370,12,06,00,1423108312,0236,3381581370, , S,    *20"},
+			expect: func(t *testing.T, m AssembledMessage) {
+				dsc := m.(DSCMessage)
+				assert.Equal(t, "3381581370", dsc.DSC.MMSI)
+				assert.Empty(t, dsc.Expansion)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Assembler
+			for i, raw := range tt.raws {
+				m, err := a.Add(mustParse(t, raw))
+				assert.NoError(t, err)
+				if i < len(tt.raws)-1 {
+					assert.Nil(t, m)
+					continue
+				}
+				if assert.NotNil(t, m) {
+					tt.expect(t, m)
+					first := m.Sentences()[0]
+					assert.Equal(t, first.TalkerID(), m.TalkerID())
+					assert.Equal(t, first.DataType(), m.DataType())
+					assert.Len(t, m.Sentences(), len(tt.raws))
+				}
+			}
+			assert.Equal(t, 0, a.Pending())
+		})
+	}
+}
+
+func TestAssembler_IgnoresOtherSentences(t *testing.T) {
+	var a Assembler
+	m, err := a.Add(mustParse(t, "$GPHDT,274.07,T*03"))
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+	assert.Equal(t, 0, a.Pending())
+}
+
+func TestAssembler_KeepsSequencesApart(t *testing.T) {
+	var a Assembler
+	m, err := a.Add(mustParse(t, "$GNTXT,02,01,07,hello *17"))
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+	m, err = a.Add(mustParse(t, "$GNTXT,01,01,02,u-blox AG - www.u-blox.com*4E"))
+	assert.NoError(t, err)
+	assert.Equal(t, "u-blox AG - www.u-blox.com", m.(TXTMessage).Message)
+	assert.Equal(t, 1, a.Pending())
+
+	m, err = a.Add(mustParse(t, "$GNTXT,02,02,07,world*34"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", m.(TXTMessage).Message)
+}
+
+func TestAssembler_RepeatedPartStartsNewSequence(t *testing.T) {
+	var a Assembler
+	_, _ = a.Add(mustParse(t, "$IIRTE,2,1,c,Rte 1,411,412*72"))
+	_, _ = a.Add(mustParse(t, "$IIRTE,2,1,c,Rte 1,411,412*72"))
+	m, err := a.Add(mustParse(t, "$IIRTE,2,2,c,Rte 1,413*68"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"411", "412", "413"}, m.(RTEMessage).Idents)
+}
+
+func TestAssembler_Timeout(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := Assembler{
+		Timeout: time.Second,
+		Now:     func() time.Time { return now },
+	}
+	m, err := a.Add(mustParse(t, "$GNTXT,02,01,07,hello *17"))
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+	assert.Equal(t, 1, a.Pending())
+
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, 0, a.Pending())
+	m, err = a.Add(mustParse(t, "$GNTXT,02,02,07,world*34"))
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+	assert.Equal(t, 1, a.Pending())
+
+	a.Reset()
+	assert.Equal(t, 0, a.Pending())
+}
+
+func TestAssembler_Errors(t *testing.T) {
+	var a Assembler
+	_, err := a.Add(mustParse(t, "$GNTXT,02,03,07,x*2F"))
+	assert.EqualError(t, err, "nmea: GNTXT invalid sequence number 3 of 2")
+
+	_, err = a.Add(mustParse(t, "$GNTXT,100,01,07,x*1E"))
+	assert.EqualError(t, err, "nmea: GNTXT invalid sequence number 1 of 100")
+
+	_, err = a.Add(mustParse(t, "$CDDSE,1,1,A,1234567890,00,46504437*16"))
+	assert.EqualError(t, err, "nmea: CDDSE expansion for MMSI 1234567890 without preceding DSC sentence")
+	assert.Equal(t, 0, a.Pending())
+}
@@ -39,7 +39,7 @@ type BBM struct {
 
 // newBBM constructor
 func newBBM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeBBM)
 	m := BBM{
 		BaseSentence:     s,
@@ -52,3 +52,15 @@ func newBBM(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the BBM sentence fields in wire format
+func (s BBM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.NumFragments)
+	e.Int64(s.FragmentNumber)
+	e.Int64(s.MessageID)
+	e.String(s.Channel)
+	e.Int64(s.VDLMessageNumber)
+	e.SixBitASCIIArmour(s.Payload, "payload")
+	return e.Fields()
+}
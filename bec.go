@@ -27,7 +27,7 @@ type BEC struct {
 
 // newBEC constructor
 func newBEC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeBEC)
 	return BEC{
 		BaseSentence:               s,
@@ -43,3 +43,24 @@ func newBEC(s BaseSentence) (Sentence, error) {
 		DestinationWaypointID:      p.String(11, "destination waypoint ID"),
 	}, p.Err()
 }
+
+// WaypointPosition returns position of the waypoint
+func (s BEC) WaypointPosition() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the BEC sentence fields in wire format
+func (s BEC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.BearingTrue, 3)
+	e.Bool(s.BearingTrueValid, BearingTrue, "")
+	e.Float64(s.BearingMagnetic, 3)
+	e.Bool(s.BearingMagneticValid, BearingMagnetic, "")
+	e.Float64(s.DistanceNauticalMiles, 3)
+	e.Bool(s.DistanceNauticalMilesValid, DistanceUnitNauticalMile, "")
+	e.String(s.DestinationWaypointID)
+	return e.Fields()
+}
@@ -25,7 +25,7 @@ type BOD struct {
 
 // newBOD constructor
 func newBOD(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeBOD)
 	bod := BOD{
 		BaseSentence:          s,
@@ -43,3 +43,31 @@ func newBOD(s BaseSentence) (Sentence, error) {
 	}
 	return bod, p.Err()
 }
+
+// FillBearings returns copy of the sentence with missing true or magnetic bearing calculated from the other bearing
+// and magnetic declination (degrees, positive east, see MagneticDeclination)
+func (s BOD) FillBearings(declination float64) BOD {
+	switch {
+	case s.BearingTrueType == "" && s.BearingMagneticType == BearingMagnetic:
+		s.BearingTrue = trueFromMagnetic(s.BearingMagnetic, declination)
+		s.BearingTrueType = BearingTrue
+	case s.BearingMagneticType == "" && s.BearingTrueType == BearingTrue:
+		s.BearingMagnetic = magneticFromTrue(s.BearingTrue, declination)
+		s.BearingMagneticType = BearingMagnetic
+	}
+	return s
+}
+
+// EncodeFields returns the BOD sentence fields in wire format
+func (s BOD) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.BearingTrue, 3)
+	e.String(s.BearingTrueType)
+	e.Float64(s.BearingMagnetic, 3)
+	e.String(s.BearingMagneticType)
+	e.String(s.DestinationWaypointID)
+	if len(s.Fields) > 5 || s.OriginWaypointID != "" {
+		e.String(s.OriginWaypointID)
+	}
+	return e.Fields()
+}
@@ -30,7 +30,7 @@ type BWC struct {
 
 // newBWC constructor
 func newBWC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeBWC)
 	bwc := BWC{
 		BaseSentence:              s,
@@ -50,3 +50,41 @@ func newBWC(s BaseSentence) (Sentence, error) {
 	}
 	return bwc, p.Err()
 }
+
+// WaypointPosition returns position of the waypoint
+func (s BWC) WaypointPosition() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// FillBearings returns copy of the sentence with missing true or magnetic bearing calculated from the other bearing
+// and magnetic declination (degrees, positive east, see MagneticDeclination)
+func (s BWC) FillBearings(declination float64) BWC {
+	switch {
+	case s.BearingTrueType == "" && s.BearingMagneticType == BearingMagnetic:
+		s.BearingTrue = trueFromMagnetic(s.BearingMagnetic, declination)
+		s.BearingTrueType = BearingTrue
+	case s.BearingMagneticType == "" && s.BearingTrueType == BearingTrue:
+		s.BearingMagnetic = magneticFromTrue(s.BearingTrue, declination)
+		s.BearingMagneticType = BearingMagnetic
+	}
+	return s
+}
+
+// EncodeFields returns the BWC sentence fields in wire format
+func (s BWC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.BearingTrue, 3)
+	e.String(s.BearingTrueType)
+	e.Float64(s.BearingMagnetic, 3)
+	e.String(s.BearingMagneticType)
+	e.Float64(s.DistanceNauticalMiles, 3)
+	e.String(s.DistanceNauticalMilesUnit)
+	e.String(s.DestinationWaypointID)
+	if len(s.Fields) > 12 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
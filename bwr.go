@@ -29,7 +29,7 @@ type BWR struct {
 
 // newBWR constructor
 func newBWR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeBWR)
 	bwc := BWR{
 		BaseSentence:              s,
@@ -49,3 +49,27 @@ func newBWR(s BaseSentence) (Sentence, error) {
 	}
 	return bwc, p.Err()
 }
+
+// WaypointPosition returns position of the waypoint
+func (s BWR) WaypointPosition() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the BWR sentence fields in wire format
+func (s BWR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.BearingTrue, 3)
+	e.String(s.BearingTrueType)
+	e.Float64(s.BearingMagnetic, 3)
+	e.String(s.BearingMagneticType)
+	e.Float64(s.DistanceNauticalMiles, 3)
+	e.String(s.DistanceNauticalMilesUnit)
+	e.String(s.DestinationWaypointID)
+	if len(s.Fields) > 12 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
@@ -25,7 +25,7 @@ type BWW struct {
 
 // newBWW constructor
 func newBWW(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeBWW)
 	bod := BWW{
 		BaseSentence:          s,
@@ -38,3 +38,15 @@ func newBWW(s BaseSentence) (Sentence, error) {
 	}
 	return bod, p.Err()
 }
+
+// EncodeFields returns the BWW sentence fields in wire format
+func (s BWW) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.BearingTrue, 3)
+	e.String(s.BearingTrueType)
+	e.Float64(s.BearingMagnetic, 3)
+	e.String(s.BearingMagneticType)
+	e.String(s.DestinationWaypointID)
+	e.String(s.OriginWaypointID)
+	return e.Fields()
+}
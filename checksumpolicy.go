@@ -0,0 +1,101 @@
+package nmea
+
+// ChecksumPolicy defines how SentenceParser handles sentences with missing or invalid checksum
+type ChecksumPolicy int
+
+const (
+	// ChecksumRequire requires sentence to have valid checksum. Sentences with missing or invalid checksum are
+	// rejected with ChecksumError. Checking is done by SentenceParser.CheckCRC when it is set. This is the default.
+	ChecksumRequire ChecksumPolicy = iota
+	// ChecksumAcceptMissing accepts sentences without checksum. Sentences with invalid checksum are rejected.
+	ChecksumAcceptMissing
+	// ChecksumIgnoreMismatch accepts sentences with missing or invalid checksum. Sentence is flagged with
+	// BaseSentence.ChecksumStatus.
+	ChecksumIgnoreMismatch
+	// ChecksumRepair accepts sentences with missing or invalid checksum and replaces the checksum with the calculated
+	// one in BaseSentence.Checksum and BaseSentence.Raw, so the sentence can be forwarded as is. Sentence is flagged
+	// with BaseSentence.ChecksumStatus of the received checksum.
+	ChecksumRepair
+)
+
+// ChecksumStatus describes the checksum the sentence was received with
+type ChecksumStatus int
+
+const (
+	// ChecksumValid means that checksum was present and matched the calculated checksum. It is the zero value, as
+	// sentences created in code get valid checksum when encoded.
+	ChecksumValid ChecksumStatus = iota
+	// ChecksumMissing means that sentence was received without checksum
+	ChecksumMissing
+	// ChecksumMismatch means that checksum was present but did not match the calculated checksum
+	ChecksumMismatch
+)
+
+// Present reports if the sentence was received with checksum
+func (s ChecksumStatus) Present() bool {
+	return s != ChecksumMissing
+}
+
+// Valid reports if the sentence was received with checksum that matched the calculated checksum
+func (s ChecksumStatus) Valid() bool {
+	return s == ChecksumValid
+}
+
+// String returns status name
+func (s ChecksumStatus) String() string {
+	switch s {
+	case ChecksumValid:
+		return "valid"
+	case ChecksumMissing:
+		return "missing"
+	case ChecksumMismatch:
+		return "mismatch"
+	}
+	return "unknown"
+}
+
+// ChecksumPolicyRule sets checksum policy for sentences of a talker, sentence type or both
+type ChecksumPolicyRule struct {
+	// Talker is the talker ID the rule applies to (e.g. SD, `P` for proprietary sentences). Empty matches all talkers.
+	Talker string
+	// Type is the sentence type the rule applies to (e.g. DBT). Empty matches all sentence types.
+	Type string
+	// Policy is the checksum policy for matching sentences
+	Policy ChecksumPolicy
+}
+
+// checksumPolicy returns checksum policy for the sentence. Rule with both talker and type is preferred over rule
+// with type only, which is preferred over rule with talker only. Rule with empty talker and type matches all
+// sentences. When no rule matches, ChecksumPolicy is used.
+func (p *SentenceParser) checksumPolicy(talker string, sentenceType string) ChecksumPolicy {
+	policy := p.ChecksumPolicy
+	best := -1
+	for _, r := range p.ChecksumPolicies {
+		if (r.Talker != "" && r.Talker != talker) || (r.Type != "" && r.Type != sentenceType) {
+			continue
+		}
+		rank := 0
+		if r.Type != "" {
+			rank += 2
+		}
+		if r.Talker != "" {
+			rank++
+		}
+		if rank > best {
+			policy = r.Policy
+			best = rank
+		}
+	}
+	return policy
+}
+
+// checksumStatus compares sentence checksum with the calculated checksum
+func checksumStatus(checksum string, calculated string) ChecksumStatus {
+	if checksum == "" {
+		return ChecksumMissing
+	}
+	if checksum != calculated {
+		return ChecksumMismatch
+	}
+	return ChecksumValid
+}
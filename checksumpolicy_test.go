@@ -0,0 +1,210 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentenceParser_ChecksumPolicy(t *testing.T) {
+	var testCases = []struct {
+		name           string
+		givenPolicy    ChecksumPolicy
+		whenInput      string
+		expectStatus   ChecksumStatus
+		expectChecksum string
+		expectRaw      string
+		expectError    string
+	}{
+		{
+			name:           "ok, require, valid checksum",
+			givenPolicy:    ChecksumRequire,
+			whenInput:      "$SDDBT,7.8,f,2.4,M,1.3,F*0D",
+			expectStatus:   ChecksumValid,
+			expectChecksum: "0D",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F*0D",
+		},
+		{
+			name:        "nok, require, missing checksum",
+			givenPolicy: ChecksumRequire,
+			whenInput:   "$SDDBT,7.8,f,2.4,M,1.3,F",
+			expectError: "nmea: sentence does not contain checksum separator",
+		},
+		{
+			name:        "nok, require, invalid checksum",
+			givenPolicy: ChecksumRequire,
+			whenInput:   "$SDDBT,7.8,f,2.4,M,1.3,F*FF",
+			expectError: "nmea: sentence checksum mismatch [0D != FF]",
+		},
+		{
+			name:           "ok, accept missing, missing checksum",
+			givenPolicy:    ChecksumAcceptMissing,
+			whenInput:      "$SDDBT,7.8,f,2.4,M,1.3,F",
+			expectStatus:   ChecksumMissing,
+			expectChecksum: "",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F",
+		},
+		{
+			name:        "nok, accept missing, invalid checksum",
+			givenPolicy: ChecksumAcceptMissing,
+			whenInput:   "$SDDBT,7.8,f,2.4,M,1.3,F*FF",
+			expectError: "nmea: sentence checksum mismatch [0D != FF]",
+		},
+		{
+			name:           "ok, ignore mismatch, invalid checksum",
+			givenPolicy:    ChecksumIgnoreMismatch,
+			whenInput:      "$SDDBT,7.8,f,2.4,M,1.3,F*FF",
+			expectStatus:   ChecksumMismatch,
+			expectChecksum: "FF",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F*FF",
+		},
+		{
+			name:           "ok, ignore mismatch, missing checksum",
+			givenPolicy:    ChecksumIgnoreMismatch,
+			whenInput:      "$SDDBT,7.8,f,2.4,M,1.3,F",
+			expectStatus:   ChecksumMissing,
+			expectChecksum: "",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F",
+		},
+		{
+			name:           "ok, repair, invalid checksum",
+			givenPolicy:    ChecksumRepair,
+			whenInput:      "$SDDBT,7.8,f,2.4,M,1.3,F*FF",
+			expectStatus:   ChecksumMismatch,
+			expectChecksum: "0D",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F*0D",
+		},
+		{
+			name:           "ok, repair, missing checksum",
+			givenPolicy:    ChecksumRepair,
+			whenInput:      `\s:r003669945*09\$SDDBT,7.8,f,2.4,M,1.3,F`,
+			expectStatus:   ChecksumMissing,
+			expectChecksum: "0D",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F*0D",
+		},
+		{
+			name:           "ok, repair, valid checksum",
+			givenPolicy:    ChecksumRepair,
+			whenInput:      "$SDDBT,7.8,f,2.4,M,1.3,F*0d",
+			expectStatus:   ChecksumValid,
+			expectChecksum: "0D",
+			expectRaw:      "$SDDBT,7.8,f,2.4,M,1.3,F*0d",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := SentenceParser{ChecksumPolicy: tc.givenPolicy}
+
+			s, err := p.Parse(tc.whenInput)
+			if tc.expectError != "" {
+				assert.EqualError(t, err, tc.expectError)
+				assert.Nil(t, s)
+				return
+			}
+			assert.NoError(t, err)
+			dbt := s.(DBT)
+			assert.Equal(t, tc.expectStatus, dbt.ChecksumStatus)
+			assert.Equal(t, tc.expectChecksum, dbt.Checksum)
+			assert.Equal(t, tc.expectRaw, dbt.Raw)
+			assert.Equal(t, tc.expectRaw, dbt.String())
+			assert.Equal(t, 2.4, dbt.DepthMeters)
+		})
+	}
+}
+
+func TestSentenceParser_ChecksumPolicies(t *testing.T) {
+	p := SentenceParser{
+		ChecksumPolicies: []ChecksumPolicyRule{
+			{Talker: "SD", Policy: ChecksumAcceptMissing},
+			{Type: TypeROT, Policy: ChecksumIgnoreMismatch},
+			{Talker: "SD", Type: TypeDBT, Policy: ChecksumRepair},
+		},
+	}
+
+	// talker and type rule is preferred over talker rule
+	s, err := p.Parse("$SDDBT,7.8,f,2.4,M,1.3,F")
+	assert.NoError(t, err)
+	assert.Equal(t, "$SDDBT,7.8,f,2.4,M,1.3,F*0D", s.String())
+
+	// talker rule
+	s, err = p.Parse("$SDDPT,2.4,0.5")
+	assert.NoError(t, err)
+	assert.Equal(t, ChecksumMissing, s.(DPT).ChecksumStatus)
+
+	_, err = p.Parse("$SDDPT,2.4,0.5*FF")
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [54 != FF]")
+
+	// type rule
+	s, err = p.Parse("$HEROT,-11.23,A*FF")
+	assert.NoError(t, err)
+	assert.Equal(t, ChecksumMismatch, s.(ROT).ChecksumStatus)
+
+	// default policy for other sentences
+	_, err = p.Parse("$GPHDT,123.456,T")
+	assert.EqualError(t, err, "nmea: sentence does not contain checksum separator")
+}
+
+func TestSentenceParser_ChecksumPoliciesMatchAll(t *testing.T) {
+	p := SentenceParser{
+		ChecksumPolicies: []ChecksumPolicyRule{
+			{Policy: ChecksumAcceptMissing},
+			{Type: TypeROT, Policy: ChecksumIgnoreMismatch},
+		},
+	}
+
+	// rule without talker and type is used instead of ChecksumPolicy
+	s, err := p.Parse("$GPHDT,123.456,T")
+	assert.NoError(t, err)
+	assert.Equal(t, ChecksumMissing, s.(HDT).ChecksumStatus)
+
+	_, err = p.Parse("$GPHDT,123.456,T*FF")
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [32 != FF]")
+
+	// more specific rule is preferred
+	s, err = p.Parse("$HEROT,-11.23,A*FF")
+	assert.NoError(t, err)
+	assert.Equal(t, ChecksumMismatch, s.(ROT).ChecksumStatus)
+}
+
+func TestSentenceParser_ChecksumPolicyWithCheckCRC(t *testing.T) {
+	called := false
+	p := SentenceParser{
+		CheckCRC: func(sentence BaseSentence, rawFields string) error {
+			called = true
+			return nil
+		},
+		ChecksumPolicies: []ChecksumPolicyRule{{Talker: "SD", Policy: ChecksumAcceptMissing}},
+	}
+
+	s, err := p.Parse("$SDDBT,7.8,f,2.4,M,1.3,F")
+	assert.NoError(t, err)
+	assert.False(t, called)
+	assert.Equal(t, ChecksumMissing, s.(DBT).ChecksumStatus)
+
+	// CheckCRC is used with ChecksumRequire policy, status tells that checksum did not match
+	s, err = p.Parse("$HEROT,-11.23,A*FF")
+	assert.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, ChecksumMismatch, s.(ROT).ChecksumStatus)
+}
+
+func TestChecksumStatus(t *testing.T) {
+	var testCases = []struct {
+		when          ChecksumStatus
+		expectPresent bool
+		expectValid   bool
+		expectString  string
+	}{
+		{when: ChecksumValid, expectPresent: true, expectValid: true, expectString: "valid"},
+		{when: ChecksumMissing, expectPresent: false, expectValid: false, expectString: "missing"},
+		{when: ChecksumMismatch, expectPresent: true, expectValid: false, expectString: "mismatch"},
+		{when: ChecksumStatus(99), expectPresent: true, expectValid: false, expectString: "unknown"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expectString, func(t *testing.T) {
+			assert.Equal(t, tc.expectPresent, tc.when.Present())
+			assert.Equal(t, tc.expectValid, tc.when.Valid())
+			assert.Equal(t, tc.expectString, tc.when.String())
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package nmea
+
+import "time"
+
+// Clock reconstructs full UTC time of sentences that contain only time of day (GGA, GLL, GNS, TTM, TLL, BWC, BWR,
+// BEC). Clock learns current date from RMC and ZDA sentences and time of day from every sentence with time, so
+// sentences after midnight are on the next day even before next RMC or ZDA is received. When date is not known yet,
+// tag block unix time (`c:`) is used.
+//
+// Clock is not safe for concurrent use.
+type Clock struct {
+	// Reference is approximate time of the data used to resolve century of two digit years and GPS week rollover
+	// before the first date is known (see ResolveDateTime). Current time is used when zero.
+	Reference time.Time
+
+	last  time.Time
+	known bool
+}
+
+// Update feeds sentence to the clock and returns UTC time of the sentence. Sentences without time get tag block time
+// when it exists. Returns false when time of the sentence can not be determined.
+func (c *Clock) Update(s Sentence) (time.Time, bool) {
+	if t, ok := c.dateTimeOf(s); ok {
+		c.set(t)
+		return t, true
+	}
+
+	var tagTime time.Time
+	hasTagTime := false
+	if b, ok := s.(baseSentencer); ok {
+		tagTime, hasTagTime = b.baseSentence().TagBlock.Timestamp()
+	}
+	tod, hasTime := timeOfDay(s)
+	if !hasTime {
+		return tagTime, hasTagTime
+	}
+	ref := c.last
+	if !c.known {
+		if !hasTagTime {
+			return time.Time{}, false
+		}
+		ref = tagTime
+	}
+	t, err := TimeOfDay(ref, tod)
+	if err != nil {
+		return time.Time{}, false
+	}
+	c.set(t)
+	return t, true
+}
+
+// Time returns the latest known UTC time. Returns false when no date is known yet.
+func (c *Clock) Time() (time.Time, bool) {
+	return c.last, c.known
+}
+
+// Reset forgets the known date and time
+func (c *Clock) Reset() {
+	c.last = time.Time{}
+	c.known = false
+}
+
+func (c *Clock) set(t time.Time) {
+	c.last = t
+	c.known = true
+}
+
+// dateTimeOf returns full time of sentence that contains date (RMC, ZDA)
+func (c *Clock) dateTimeOf(s Sentence) (time.Time, bool) {
+	ref := c.Reference
+	if c.known {
+		ref = c.last
+	}
+	var (
+		t   time.Time
+		err error
+	)
+	switch m := s.(type) {
+	case RMC:
+		if !m.Date.Valid {
+			return time.Time{}, false
+		}
+		t, err = m.Timestamp(ref)
+	case ZDA:
+		if m.Year == 0 {
+			return time.Time{}, false
+		}
+		t, err = m.Timestamp(ref)
+	default:
+		return time.Time{}, false
+	}
+	return t, err == nil
+}
+
+// timeOfDay returns time of day of the sentence
+func timeOfDay(s Sentence) (Time, bool) {
+	var t Time
+	switch m := s.(type) {
+	case GGA:
+		t = m.Time
+	case GLL:
+		t = m.Time
+	case GNS:
+		t = m.Time
+	case RMC:
+		t = m.Time
+	case ZDA:
+		t = m.Time
+	case TTM:
+		t = m.TimeUTC
+	case TLL:
+		t = m.TimeUTC
+	case BWC:
+		t = m.Time
+	case BWR:
+		t = m.Time
+	case BEC:
+		t = m.Time
+	}
+	return t, t.Valid
+}
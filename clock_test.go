@@ -0,0 +1,128 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock_Update(t *testing.T) {
+	type step struct {
+		raw      string
+		expect   time.Time
+		expectOk bool
+	}
+	var testCases = []struct {
+		name  string
+		clock Clock
+		steps []step
+	}{
+		{
+			name:  "date from RMC and midnight rollover",
+			clock: Clock{Reference: time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)},
+			steps: []step{
+				{raw: "$GPGGA,235958,4916.45,N,12311.12,W,1,08,0.9,545.4,M,46.9,M,,*52"},
+				{
+					raw:      "$GPRMC,235959,A,4916.45,N,12311.12,W,000.5,054.7,311219,020.3,E*66",
+					expect:   time.Date(2019, 12, 31, 23, 59, 59, 0, time.UTC),
+					expectOk: true,
+				},
+				{
+					raw:      "$GPGGA,000001,4916.45,N,12311.12,W,1,08,0.9,545.4,M,46.9,M,,*53",
+					expect:   time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC),
+					expectOk: true,
+				},
+				{
+					raw:      "$GPGGA,235958,4916.45,N,12311.12,W,1,08,0.9,545.4,M,46.9,M,,*52",
+					expect:   time.Date(2019, 12, 31, 23, 59, 58, 0, time.UTC),
+					expectOk: true,
+				},
+				{raw: "$HEHDT,123.4,T*2B"},
+			},
+		},
+		{
+			name: "date from ZDA",
+			steps: []step{
+				{
+					raw:      "$GPZDA,172809.456,12,07,1996,00,00*57",
+					expect:   time.Date(1996, 7, 12, 17, 28, 9, 456e6, time.UTC),
+					expectOk: true,
+				},
+				{
+					raw:      "$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58",
+					expect:   time.Date(1996, 7, 13, 2, 27, 32, 0, time.UTC),
+					expectOk: true,
+				},
+			},
+		},
+		{
+			name: "tag block time when date is not known",
+			steps: []step{
+				{
+					raw:      "\\s:r1,c:1577923200*71\\$GPGGA,235958,4916.45,N,12311.12,W,1,08,0.9,545.4,M,46.9,M,,*52",
+					expect:   time.Date(2020, 1, 1, 23, 59, 58, 0, time.UTC),
+					expectOk: true,
+				},
+				{
+					raw:      "\\c:1577923200500*62\\$HEHDT,123.4,T*2B",
+					expect:   time.Date(2020, 1, 2, 0, 0, 0, 500e6, time.UTC),
+					expectOk: true,
+				},
+				{
+					raw:      "$GPGGA,000001,4916.45,N,12311.12,W,1,08,0.9,545.4,M,46.9,M,,*53",
+					expect:   time.Date(2020, 1, 2, 0, 0, 1, 0, time.UTC),
+					expectOk: true,
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := tc.clock
+			for _, st := range tc.steps {
+				s, err := Parse(st.raw)
+				if !assert.NoError(t, err) {
+					return
+				}
+				result, ok := clock.Update(s)
+				assert.Equal(t, st.expectOk, ok, st.raw)
+				assert.Equal(t, st.expect, result, st.raw)
+			}
+		})
+	}
+}
+
+func TestClock_TimeOnlySentences(t *testing.T) {
+	clock := Clock{}
+	_, ok := clock.Time()
+	assert.False(t, ok)
+
+	clock.Update(RMC{Date: Date{Valid: true, DD: 1, MM: 3, YY: 20}, Time: Time{Valid: true, Hour: 12}})
+	now, ok := clock.Time()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC), now)
+
+	var testCases = []struct {
+		name string
+		when Sentence
+	}{
+		{name: "GNS", when: GNS{Time: Time{Valid: true, Hour: 12, Second: 1}}},
+		{name: "TTM", when: TTM{TimeUTC: Time{Valid: true, Hour: 12, Second: 1}}},
+		{name: "TLL", when: TLL{TimeUTC: Time{Valid: true, Hour: 12, Second: 1}}},
+		{name: "BWC", when: BWC{Time: Time{Valid: true, Hour: 12, Second: 1}}},
+		{name: "RMC without date", when: RMC{Time: Time{Valid: true, Hour: 12, Second: 1}}},
+		{name: "ZDA without date", when: ZDA{Time: Time{Valid: true, Hour: 12, Second: 1}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, ok := clock.Update(tc.when)
+			assert.True(t, ok)
+			assert.Equal(t, time.Date(2020, 3, 1, 12, 0, 1, 0, time.UTC), result)
+		})
+	}
+
+	clock.Reset()
+	_, ok = clock.Update(GGA{Time: Time{Valid: true, Hour: 12}})
+	assert.False(t, ok)
+}
@@ -0,0 +1,260 @@
+package nmea
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DatagramTokenSentence is header token of IEC 61162-450 datagram carrying sentences
+	DatagramTokenSentence = "UdPbC"
+	// DatagramTokenBinary is header token of IEC 61162-450 datagram carrying binary file fragment
+	DatagramTokenBinary = "RaUdP"
+	// DatagramTokenBinaryRetransmittable is header token of IEC 61162-450 datagram carrying binary file fragment
+	// that can be retransmitted on request
+	DatagramTokenBinaryRetransmittable = "RrUdP"
+
+	// datagramTokenLen is length of the header token including terminating null character
+	datagramTokenLen = 6
+	// maxLineCount is the largest value of tag block line count (n:), next value after it is 1
+	maxLineCount = 999
+)
+
+// binaryHeaderLen is length of binary file fragment header (token excluded)
+const binaryHeaderLen = 2 + 4 + 6 + 6 + 2 + 4 + 4 + 4 + 1 + 1
+
+// Datagram is decoded IEC 61162-450 datagram. Sentence datagram (UdPbC) contains lines with tag block and sentence,
+// binary datagram (RaUdP, RrUdP) contains binary file fragment.
+type Datagram struct {
+	Token  string         // header token without null character
+	Lines  []DatagramLine // lines of sentence datagram
+	Binary *BinaryFragment
+}
+
+// Sentences returns successfully parsed sentences of the datagram in line order
+func (d Datagram) Sentences() []Sentence {
+	result := make([]Sentence, 0, len(d.Lines))
+	for _, l := range d.Lines {
+		if l.Sentence != nil {
+			result = append(result, l.Sentence)
+		}
+	}
+	return result
+}
+
+// DatagramLine is single line of sentence datagram
+type DatagramLine struct {
+	Raw      string   // line without <CR><LF>
+	TagBlock TagBlock // tag block of the line
+	Sentence Sentence // parsed sentence, nil for lines with only a tag block or invalid lines
+	Err      error    // error of parsing the line
+}
+
+// BinaryFragment is a fragment of binary file (for example radar image) transferred with RaUdP/RrUdP datagrams.
+// Header fields are big-endian and follow the header token in the order of the struct fields.
+type BinaryFragment struct {
+	Version           uint16
+	HeaderLength      uint32 // length of the header including token, Data follows the header
+	SourceID          string // 6 characters, for example "RA0001"
+	DestinationID     string // 6 characters
+	Type              uint16 // type of the message
+	BlockID           uint32 // identifier of the transferred file
+	SequenceNumber    uint32 // fragment sequence number, starting from 1
+	MaxSequenceNumber uint32 // total number of fragments
+	Device            uint8
+	Channel           uint8
+	Data              []byte
+}
+
+// DatagramDecoder decodes IEC 61162-450 datagrams. Sentences are parsed with Parser. Decoder tracks tag block
+// line counts (n:) per source (s:) to detect lost sentences.
+//
+// DatagramDecoder is not safe for concurrent use by multiple goroutines.
+type DatagramDecoder struct {
+	// Parser is used to parse sentences. When nil, parser used by the package level Parse function is used.
+	Parser *SentenceParser
+
+	lineCounts LineCountTracker
+}
+
+// Decode decodes the datagram. Error is returned when datagram does not have valid header. Errors of single lines
+// are reported in DatagramLine.Err.
+func (d *DatagramDecoder) Decode(datagram []byte) (Datagram, error) {
+	if len(datagram) < datagramTokenLen || datagram[datagramTokenLen-1] != 0 {
+		return Datagram{}, errors.New("nmea: datagram does not start with header token")
+	}
+	token := string(datagram[:datagramTokenLen-1])
+	body := datagram[datagramTokenLen:]
+	switch token {
+	case DatagramTokenSentence:
+		return Datagram{Token: token, Lines: d.decodeLines(body)}, nil
+	case DatagramTokenBinary, DatagramTokenBinaryRetransmittable:
+		fragment, err := decodeBinaryFragment(body)
+		if err != nil {
+			return Datagram{}, err
+		}
+		return Datagram{Token: token, Binary: fragment}, nil
+	}
+	return Datagram{}, fmt.Errorf("nmea: datagram header token %q is not supported", token)
+}
+
+// Lost returns total number of lost lines detected from line counts of the source
+func (d *DatagramDecoder) Lost(source string) int64 {
+	return d.lineCounts.Lost(source)
+}
+
+func (d *DatagramDecoder) decodeLines(body []byte) []DatagramLine {
+	p := d.Parser
+	if p == nil {
+		p = &defaultSentenceParser
+	}
+	var lines []DatagramLine
+	for _, raw := range strings.Split(string(body), "\n") {
+		raw = strings.TrimRight(raw, "\r\x00")
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		line := DatagramLine{Raw: raw}
+		tagBlock, tagBlockLen, err := ParseTagBlock(raw)
+		line.TagBlock = tagBlock
+		switch {
+		case err != nil:
+			line.Err = err
+		case tagBlockLen == len(raw) && tagBlockLen > 0:
+			// line with only a tag block
+		default:
+			line.Sentence, line.Err = p.Parse(raw)
+		}
+		if err == nil && tagBlock.Source != "" && tagBlock.LineCount != 0 {
+			d.lineCounts.Observe(tagBlock.Source, tagBlock.LineCount)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func decodeBinaryFragment(body []byte) (*BinaryFragment, error) {
+	if len(body) < binaryHeaderLen {
+		return nil, fmt.Errorf("nmea: binary datagram header is too short [%d]", len(body))
+	}
+	f := &BinaryFragment{
+		Version:           binary.BigEndian.Uint16(body[0:]),
+		HeaderLength:      binary.BigEndian.Uint32(body[2:]),
+		SourceID:          strings.TrimRight(string(body[6:12]), "\x00"),
+		DestinationID:     strings.TrimRight(string(body[12:18]), "\x00"),
+		Type:              binary.BigEndian.Uint16(body[18:]),
+		BlockID:           binary.BigEndian.Uint32(body[20:]),
+		SequenceNumber:    binary.BigEndian.Uint32(body[24:]),
+		MaxSequenceNumber: binary.BigEndian.Uint32(body[28:]),
+		Device:            body[32],
+		Channel:           body[33],
+	}
+	dataStart := int64(f.HeaderLength) - datagramTokenLen
+	if dataStart < binaryHeaderLen || dataStart > int64(len(body)) {
+		return nil, fmt.Errorf("nmea: binary datagram header length is invalid [%d]", f.HeaderLength)
+	}
+	f.Data = append([]byte(nil), body[dataStart:]...)
+	return f, nil
+}
+
+// DatagramEncoder encodes sentences into IEC 61162-450 datagrams. Every line gets tag block with source (s:),
+// destination (d:) when set and line count (n:) that increments from 1 to 999 and wraps around.
+//
+// DatagramEncoder is not safe for concurrent use by multiple goroutines.
+type DatagramEncoder struct {
+	Source      string // source identification, for example "GP0001"
+	Destination string // destination identification, optional
+
+	lineCount int64
+}
+
+// Encode encodes sentences into single sentence datagram. Tag block parameters of sentences other than source,
+// destination and line count are kept.
+func (e *DatagramEncoder) Encode(sentences ...Sentence) ([]byte, error) {
+	if e.Source == "" {
+		return nil, errors.New("nmea: datagram encoder source is not set")
+	}
+	var buf bytes.Buffer
+	buf.WriteString(DatagramTokenSentence)
+	buf.WriteByte(0)
+	for _, s := range sentences {
+		raw, err := Encode(s)
+		if err != nil {
+			return nil, err
+		}
+		var tagBlock TagBlock
+		if b, ok := s.(baseSentencer); ok {
+			tagBlock = b.baseSentence().TagBlock
+		}
+		e.lineCount = e.lineCount%maxLineCount + 1
+		tagBlock.Source = e.Source
+		tagBlock.Destination = e.Destination
+		tagBlock.LineCount = e.lineCount
+
+		buf.WriteString(FormatTagBlock(tagBlock))
+		buf.WriteString(raw)
+		buf.WriteString("\r\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBinaryFragment encodes binary file fragment into datagram with given token (DatagramTokenBinary or
+// DatagramTokenBinaryRetransmittable). HeaderLength of the fragment is ignored and calculated.
+func EncodeBinaryFragment(token string, f BinaryFragment) ([]byte, error) {
+	if token != DatagramTokenBinary && token != DatagramTokenBinaryRetransmittable {
+		return nil, fmt.Errorf("nmea: datagram header token %q is not binary", token)
+	}
+	if len(f.SourceID) > 6 || len(f.DestinationID) > 6 {
+		return nil, errors.New("nmea: binary datagram source and destination ID can not be longer than 6 characters")
+	}
+	headerLen := datagramTokenLen + binaryHeaderLen
+	result := make([]byte, headerLen, headerLen+len(f.Data))
+	copy(result, token)
+	binary.BigEndian.PutUint16(result[6:], f.Version)
+	binary.BigEndian.PutUint32(result[8:], uint32(headerLen))
+	copy(result[12:18], f.SourceID)
+	copy(result[18:24], f.DestinationID)
+	binary.BigEndian.PutUint16(result[24:], f.Type)
+	binary.BigEndian.PutUint32(result[26:], f.BlockID)
+	binary.BigEndian.PutUint32(result[30:], f.SequenceNumber)
+	binary.BigEndian.PutUint32(result[34:], f.MaxSequenceNumber)
+	result[38] = f.Device
+	result[39] = f.Channel
+	return append(result, f.Data...), nil
+}
+
+// LineCountTracker tracks tag block line counts (n:) per source to detect lost lines. Line count increments from
+// 1 to 999 and then wraps around to 1. Zero value is ready to use.
+type LineCountTracker struct {
+	last map[string]int64
+	lost map[string]int64
+}
+
+// Observe records line count of the source and returns the number of lines lost since the previous observed line
+// of the source. Repeated line count is not counted as loss.
+func (t *LineCountTracker) Observe(source string, lineCount int64) int64 {
+	if t.last == nil {
+		t.last = map[string]int64{}
+		t.lost = map[string]int64{}
+	}
+	last, ok := t.last[source]
+	t.last[source] = lineCount
+	if !ok || lineCount == last {
+		return 0
+	}
+	expected := last%maxLineCount + 1
+	lost := (lineCount - expected + maxLineCount) % maxLineCount
+	if lost < 0 {
+		lost = 0
+	}
+	t.lost[source] += lost
+	return lost
+}
+
+// Lost returns total number of lost lines of the source
+func (t *LineCountTracker) Lost(source string) int64 {
+	return t.lost[source]
+}
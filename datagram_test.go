@@ -0,0 +1,185 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatagramDecoder_Decode(t *testing.T) {
+	datagram := []byte("UdPbC\x00" +
+		"\\s:GP0001,n:123*17\\$GPHDT,274.07,T*03\r\n" +
+		"\\s:GP0001,n:124*10\\$GPHDT,274.07,T*04\r\n" +
+		"\\g:1-2-5,s:AI0001,n:2*4D\\\r\n")
+
+	var d DatagramDecoder
+	result, err := d.Decode(datagram)
+	assert.NoError(t, err)
+	assert.Equal(t, DatagramTokenSentence, result.Token)
+	assert.Nil(t, result.Binary)
+	if assert.Len(t, result.Lines, 3) {
+		assert.Equal(t, "\\s:GP0001,n:123*17\\$GPHDT,274.07,T*03", result.Lines[0].Raw)
+		assert.NoError(t, result.Lines[0].Err)
+		assert.Equal(t, TagBlock{Source: "GP0001", LineCount: 123, order: "s,n"}, result.Lines[0].TagBlock)
+		hdt := result.Lines[0].Sentence.(HDT)
+		assert.Equal(t, 274.07, hdt.Heading)
+		assert.Equal(t, "GP0001", hdt.TagBlock.Source)
+
+		assert.EqualError(t, result.Lines[1].Err, "nmea: sentence checksum mismatch [03 != 04]")
+		assert.Nil(t, result.Lines[1].Sentence)
+
+		assert.NoError(t, result.Lines[2].Err)
+		assert.Nil(t, result.Lines[2].Sentence)
+		assert.Equal(t, "1-2-5", result.Lines[2].TagBlock.Grouping)
+	}
+	assert.Len(t, result.Sentences(), 1)
+	assert.Equal(t, int64(0), d.Lost("GP0001"))
+
+	// lines 125 and 126 are lost
+	result, err = d.Decode([]byte("UdPbC\x00\\s:GP0001,n:127*13\\$GPHDT,274.07,T*03\r\n"))
+	assert.NoError(t, err)
+	assert.Len(t, result.Sentences(), 1)
+	assert.Equal(t, int64(2), d.Lost("GP0001"))
+	assert.Equal(t, int64(0), d.Lost("AI0001"))
+}
+
+func TestDatagramDecoder_DecodeErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		datagram []byte
+		err      string
+	}{
+		{name: "empty", datagram: nil, err: "nmea: datagram does not start with header token"},
+		{name: "missing null", datagram: []byte("UdPbC$GPHDT,274.07,T*03\r\n"), err: "nmea: datagram does not start with header token"},
+		{name: "unknown token", datagram: []byte("NkPgN\x00"), err: "nmea: datagram header token \"NkPgN\" is not supported"},
+		{name: "short binary header", datagram: []byte("RaUdP\x00\x00\x01"), err: "nmea: binary datagram header is too short [2]"},
+		{
+			name: "invalid binary header length",
+			datagram: []byte("RaUdP\x00" +
+				"\x00\x01\x00\x00\x00\x05RA0001EI0001\x00\x01\x00\x00\x00\x07\x00\x00\x00\x01\x00\x00\x00\x02\x01\x02"),
+			err: "nmea: binary datagram header length is invalid [5]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d DatagramDecoder
+			_, err := d.Decode(tt.datagram)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestDatagramDecoder_DecodeBinary(t *testing.T) {
+	datagram := []byte("RaUdP\x00" +
+		"\x00\x01" + // version
+		"\x00\x00\x00\x28" + // header length (40)
+		"RA0001" + // source ID
+		"EI0001" + // destination ID
+		"\x00\x01" + // type
+		"\x00\x00\x00\x07" + // block ID
+		"\x00\x00\x00\x01" + // sequence number
+		"\x00\x00\x00\x02" + // max sequence number
+		"\x01" + // device
+		"\x02" + // channel
+		"\xde\xad\xbe\xef")
+
+	var d DatagramDecoder
+	result, err := d.Decode(datagram)
+	assert.NoError(t, err)
+	assert.Equal(t, DatagramTokenBinary, result.Token)
+	expected := &BinaryFragment{
+		Version:           1,
+		HeaderLength:      40,
+		SourceID:          "RA0001",
+		DestinationID:     "EI0001",
+		Type:              1,
+		BlockID:           7,
+		SequenceNumber:    1,
+		MaxSequenceNumber: 2,
+		Device:            1,
+		Channel:           2,
+		Data:              []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+	assert.Equal(t, expected, result.Binary)
+
+	encoded, err := EncodeBinaryFragment(DatagramTokenBinary, *expected)
+	assert.NoError(t, err)
+	assert.Equal(t, datagram, encoded)
+}
+
+func TestEncodeBinaryFragment_Errors(t *testing.T) {
+	_, err := EncodeBinaryFragment(DatagramTokenSentence, BinaryFragment{})
+	assert.EqualError(t, err, "nmea: datagram header token \"UdPbC\" is not binary")
+
+	_, err = EncodeBinaryFragment(DatagramTokenBinaryRetransmittable, BinaryFragment{SourceID: "RA00001"})
+	assert.EqualError(t, err, "nmea: binary datagram source and destination ID can not be longer than 6 characters")
+}
+
+func TestDatagramEncoder_Encode(t *testing.T) {
+	hdt, err := Parse("$GPHDT,274.07,T*03")
+	assert.NoError(t, err)
+
+	e := DatagramEncoder{Source: "GP0001"}
+	datagram, err := e.Encode(hdt, hdt)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("UdPbC\x00"+
+		"\\n:1,s:GP0001*16\\$GPHDT,274.07,T*03\r\n"+
+		"\\n:2,s:GP0001*15\\$GPHDT,274.07,T*03\r\n"), datagram)
+
+	var d DatagramDecoder
+	result, err := d.Decode(datagram)
+	assert.NoError(t, err)
+	assert.Len(t, result.Sentences(), 2)
+	assert.Equal(t, int64(0), d.Lost("GP0001"))
+
+	e = DatagramEncoder{Source: "GP0001", Destination: "EI0001"}
+	datagram, err = e.Encode(hdt)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("UdPbC\x00\\n:1,s:GP0001,d:EI0001*69\\$GPHDT,274.07,T*03\r\n"), datagram)
+
+	_, err = (&DatagramEncoder{}).Encode(hdt)
+	assert.EqualError(t, err, "nmea: datagram encoder source is not set")
+}
+
+func TestDatagramEncoder_LineCountWraps(t *testing.T) {
+	hdt, err := Parse("$GPHDT,274.07,T*03")
+	assert.NoError(t, err)
+
+	e := DatagramEncoder{Source: "GP0001", lineCount: 998}
+	datagram, err := e.Encode(hdt, hdt)
+	assert.NoError(t, err)
+
+	var d DatagramDecoder
+	result, err := d.Decode(datagram)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(999), result.Lines[0].TagBlock.LineCount)
+	assert.Equal(t, int64(1), result.Lines[1].TagBlock.LineCount)
+	assert.Equal(t, int64(0), d.Lost("GP0001"))
+}
+
+func TestLineCountTracker(t *testing.T) {
+	var tests = []struct {
+		name   string
+		counts []int64
+		lost   []int64
+	}{
+		{name: "no loss", counts: []int64{1, 2, 3}, lost: []int64{0, 0, 0}},
+		{name: "loss", counts: []int64{1, 4, 5}, lost: []int64{0, 2, 0}},
+		{name: "wrap around", counts: []int64{998, 999, 1, 2}, lost: []int64{0, 0, 0, 0}},
+		{name: "loss over wrap around", counts: []int64{998, 2}, lost: []int64{0, 2}},
+		{name: "repeated", counts: []int64{5, 5, 6}, lost: []int64{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tracker LineCountTracker
+			var total int64
+			for i, c := range tt.counts {
+				lost := tracker.Observe("src", c)
+				assert.Equal(t, tt.lost[i], lost)
+				total += lost
+			}
+			assert.Equal(t, total, tracker.Lost("src"))
+			assert.Equal(t, int64(0), tracker.Lost("other"))
+		})
+	}
+}
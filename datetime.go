@@ -0,0 +1,153 @@
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GPSWeekRolloverPeriod is the period of GPS week number rollover (1024 weeks, about 19.6 years). Receivers with
+// outdated firmware report dates that are one or more periods in the past after the rollover (e.g. 1999 instead of
+// 2019).
+const GPSWeekRolloverPeriod = 1024 * 7 * 24 * time.Hour
+
+// gpsEpoch is the start of GPS time, GPS week rollovers are whole GPSWeekRolloverPeriods after it (1999-08-22 and
+// 2019-04-07).
+var gpsEpoch = time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+
+// gpsWeekRolloverTolerance is the maximum difference between the corrected time and the reference time for the time to
+// be considered affected by GPS week rollover.
+const gpsWeekRolloverTolerance = 30 * 24 * time.Hour
+
+// Timestamper is implemented by sentences that contain UTC time of the data (RMC, ZDA, GGA, GLL, GNS)
+type Timestamper interface {
+	Sentence
+	// Timestamp returns UTC time of the sentence. Reference time is approximate current time of the data, see
+	// ResolveDateTime.
+	Timestamp(ref time.Time) (time.Time, error)
+}
+
+// ResolveDateTime returns UTC time of the date with two digit year and time of day. Century is chosen so that the
+// result is closest to the reference time. Reference time should be approximate time of the data, for example
+// time.Now() for live data or time of recording for logs. When reference time is set, dates affected by GPS week
+// rollover are corrected (see CorrectGPSWeekRollover), old dates that are not close to the reference time after
+// correction are kept. When reference time is zero, current time is used as reference and dates are not corrected.
+// Dates that do not exist (e.g. 31 April or 29 February of non-leap year) are errors.
+//
+// Leap second (23:59:60) is returned as the first second of the next minute (00:00:00), as time.Time does not
+// represent leap seconds.
+func ResolveDateTime(ref time.Time, d Date, t Time) (time.Time, error) {
+	if !d.Valid {
+		return time.Time{}, errors.New("nmea: date is not valid")
+	}
+	if err := validateTime(t); err != nil {
+		return time.Time{}, err
+	}
+	correctRollover := !ref.IsZero()
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+	ref = ref.UTC()
+
+	result := closestCentury(ref, d, t)
+	// date is validated after the century is resolved, as length of February depends on the year. Invalid day of
+	// month is normalized within the same year and leap second moves only 31 December to the next year.
+	if err := validateDate(result.Year(), d.MM, d.DD); err != nil {
+		return time.Time{}, err
+	}
+	if correctRollover {
+		result, _ = CorrectGPSWeekRollover(result, ref)
+	}
+	return result, nil
+}
+
+// closestCentury returns UTC time of the date with two digit year and time of day in the century that is closest to
+// the reference time. When two centuries are equally close, the earlier one is used.
+func closestCentury(ref time.Time, d Date, t Time) time.Time {
+	century := ref.Year() / 100 * 100
+	var result time.Time
+	for _, c := range []int{century - 100, century, century + 100} {
+		candidate := dateTimeUTC(c+d.YY, d.MM, d.DD, t)
+		if result.IsZero() || absDuration(candidate.Sub(ref)) < absDuration(result.Sub(ref)) {
+			result = candidate
+		}
+	}
+	return result
+}
+
+// TimeOfDay returns UTC time of the time of day on the date of the reference time. When the result would be more than
+// 12 hours from the reference time, previous or next day is used, so time of day just before midnight is on the
+// previous day when reference time is just after midnight. When reference time is zero, current time is used.
+func TimeOfDay(ref time.Time, t Time) (time.Time, error) {
+	if err := validateTime(t); err != nil {
+		return time.Time{}, err
+	}
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+	ref = ref.UTC()
+
+	result := dateTimeUTC(ref.Year(), int(ref.Month()), ref.Day(), t)
+	if diff := result.Sub(ref); diff > 12*time.Hour {
+		result = dateTimeUTC(ref.Year(), int(ref.Month()), ref.Day()-1, t)
+	} else if diff < -12*time.Hour {
+		result = dateTimeUTC(ref.Year(), int(ref.Month()), ref.Day()+1, t)
+	}
+	return result, nil
+}
+
+// CorrectGPSWeekRollover moves time that is affected by GPS week rollover forward by whole GPSWeekRolloverPeriods.
+// Time is affected when it is before the latest rollover preceding the reference time and the corrected time is within
+// 30 days of the reference time, so legitimate old dates (for example replayed logs with current time as reference) are
+// not changed. Returns true when the time was corrected.
+func CorrectGPSWeekRollover(t time.Time, ref time.Time) (time.Time, bool) {
+	lastRollover := gpsEpoch.Add(ref.Sub(gpsEpoch) / GPSWeekRolloverPeriod * GPSWeekRolloverPeriod)
+	if !t.Before(lastRollover) {
+		return t, false
+	}
+	periods := (ref.Sub(t) + GPSWeekRolloverPeriod/2) / GPSWeekRolloverPeriod
+	corrected := t.Add(periods * GPSWeekRolloverPeriod)
+	if periods < 1 || absDuration(corrected.Sub(ref)) > gpsWeekRolloverTolerance {
+		return t, false
+	}
+	return corrected, true
+}
+
+// dateTimeUTC creates UTC time from date and time of day. Day outside of month range is normalized.
+func dateTimeUTC(year, month, day int, t Time) time.Time {
+	return time.Date(year, time.Month(month), day, t.Hour, t.Minute, t.Second, t.Millisecond*1e6, time.UTC)
+}
+
+// validateDate checks that month is 1-12 and the day exists in the month of the year
+func validateDate(year, month, day int) error {
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return fmt.Errorf("nmea: invalid date, day %d month %d", day, month)
+	}
+	if day > daysInMonth(year, month) {
+		return fmt.Errorf("nmea: invalid date, day %d month %d year %d", day, month, year)
+	}
+	return nil
+}
+
+// daysInMonth returns number of days in the month of the year
+func daysInMonth(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func validateTime(t Time) error {
+	if !t.Valid {
+		return errors.New("nmea: time is not valid")
+	}
+	// second 60 is leap second
+	if t.Hour > 23 || t.Minute > 59 || t.Second > 60 || t.Hour < 0 || t.Minute < 0 || t.Second < 0 {
+		return fmt.Errorf("nmea: invalid time %s", t)
+	}
+	return nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
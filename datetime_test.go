@@ -0,0 +1,306 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveDateTime(t *testing.T) {
+	var testCases = []struct {
+		name      string
+		ref       time.Time
+		date      Date
+		time      Time
+		expect    time.Time
+		expectErr string
+	}{
+		{
+			name:   "century from reference time",
+			ref:    time.Date(1994, 6, 14, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 13, MM: 6, YY: 94},
+			time:   Time{Valid: true, Hour: 22, Minute: 5, Second: 16, Millisecond: 500},
+			expect: time.Date(1994, 6, 13, 22, 5, 16, 500e6, time.UTC),
+		},
+		{
+			name:   "closest century is next century",
+			ref:    time.Date(1999, 12, 31, 23, 59, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 1, MM: 1, YY: 0},
+			time:   Time{Valid: true},
+			expect: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "reference time in other time zone",
+			ref:    time.Date(2021, 1, 1, 1, 0, 0, 0, time.FixedZone("UTC+02:00", 2*3600)),
+			date:   Date{Valid: true, DD: 31, MM: 12, YY: 20},
+			time:   Time{Valid: true, Hour: 23},
+			expect: time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "GPS week rollover",
+			ref:    time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 6, MM: 10, YY: 99},
+			time:   Time{Valid: true, Hour: 12},
+			expect: time.Date(2019, 5, 22, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "GPS week rollover twice",
+			ref:    time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 11, MM: 7, YY: 87},
+			time:   Time{Valid: true, Hour: 12},
+			expect: time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "old log replayed with current time is not corrected",
+			ref:    time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 14, MM: 5, YY: 12},
+			time:   Time{Valid: true, Hour: 9, Minute: 30},
+			expect: time.Date(2012, 5, 14, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:   "date after last rollover is not corrected",
+			ref:    time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 20, MM: 1, YY: 20},
+			time:   Time{Valid: true},
+			expect: time.Date(2020, 1, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "leap second is first second of next day",
+			ref:    time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 31, MM: 12, YY: 16},
+			time:   Time{Valid: true, Hour: 23, Minute: 59, Second: 60},
+			expect: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "invalid date",
+			date:      Date{},
+			time:      Time{Valid: true},
+			expectErr: "nmea: date is not valid",
+		},
+		{
+			name:      "invalid month",
+			date:      Date{Valid: true, DD: 1, MM: 13, YY: 20},
+			time:      Time{Valid: true},
+			expectErr: "nmea: invalid date, day 1 month 13",
+		},
+		{
+			name:      "31 April",
+			ref:       time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:      Date{Valid: true, DD: 31, MM: 4, YY: 24},
+			time:      Time{Valid: true},
+			expectErr: "nmea: invalid date, day 31 month 4 year 2024",
+		},
+		{
+			name:      "29 February in non-leap year",
+			ref:       time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:      Date{Valid: true, DD: 29, MM: 2, YY: 23},
+			time:      Time{Valid: true},
+			expectErr: "nmea: invalid date, day 29 month 2 year 2023",
+		},
+		{
+			name:      "30 February",
+			ref:       time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:      Date{Valid: true, DD: 30, MM: 2, YY: 24},
+			time:      Time{Valid: true},
+			expectErr: "nmea: invalid date, day 30 month 2 year 2024",
+		},
+		{
+			name:      "31 February",
+			ref:       time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:      Date{Valid: true, DD: 31, MM: 2, YY: 24},
+			time:      Time{Valid: true},
+			expectErr: "nmea: invalid date, day 31 month 2 year 2024",
+		},
+		{
+			name:   "29 February in leap year",
+			ref:    time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 29, MM: 2, YY: 24},
+			time:   Time{Valid: true, Hour: 12},
+			expect: time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "29 February 2000 is leap day",
+			ref:    time.Date(2000, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:   Date{Valid: true, DD: 29, MM: 2, YY: 0},
+			time:   Time{Valid: true},
+			expect: time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "29 February 1900 is not leap day",
+			ref:       time.Date(1900, 6, 1, 0, 0, 0, 0, time.UTC),
+			date:      Date{Valid: true, DD: 29, MM: 2, YY: 0},
+			time:      Time{Valid: true},
+			expectErr: "nmea: invalid date, day 29 month 2 year 1900",
+		},
+		{
+			name:      "invalid time",
+			date:      Date{Valid: true, DD: 1, MM: 1, YY: 20},
+			time:      Time{},
+			expectErr: "nmea: time is not valid",
+		},
+		{
+			name:      "time out of range",
+			date:      Date{Valid: true, DD: 1, MM: 1, YY: 20},
+			time:      Time{Valid: true, Hour: 24},
+			expectErr: "nmea: invalid time 24:00:00.0000",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ResolveDateTime(tc.ref, tc.date, tc.time)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, result)
+		})
+	}
+}
+
+func TestResolveDateTime_ZeroReference(t *testing.T) {
+	result, err := ResolveDateTime(time.Time{}, Date{Valid: true, DD: 6, MM: 10, YY: 99}, Time{Valid: true})
+	assert.NoError(t, err)
+	// without reference time dates are not corrected for GPS week rollover
+	assert.Equal(t, time.Date(1999, 10, 6, 0, 0, 0, 0, time.UTC), result)
+}
+
+func TestResolveDateTime_LeapSecondIsMonotonic(t *testing.T) {
+	ref := time.Date(2016, 12, 31, 23, 0, 0, 0, time.UTC)
+	date := Date{Valid: true, DD: 31, MM: 12, YY: 16}
+
+	var previous time.Time
+	for _, second := range []int{58, 59, 60} {
+		result, err := ResolveDateTime(ref, date, Time{Valid: true, Hour: 23, Minute: 59, Second: second})
+		assert.NoError(t, err)
+		assert.False(t, result.Before(previous))
+		previous = result
+	}
+	next, err := ResolveDateTime(ref, Date{Valid: true, DD: 1, MM: 1, YY: 17}, Time{Valid: true})
+	assert.NoError(t, err)
+	// leap second and the following second are the same instant
+	assert.Equal(t, previous, next)
+}
+
+func TestTimeOfDay(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		ref    time.Time
+		time   Time
+		expect time.Time
+	}{
+		{
+			name:   "same day",
+			ref:    time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC),
+			time:   Time{Valid: true, Hour: 10, Minute: 30, Second: 5, Millisecond: 250},
+			expect: time.Date(2020, 3, 4, 10, 30, 5, 250e6, time.UTC),
+		},
+		{
+			name:   "previous day",
+			ref:    time.Date(2020, 3, 1, 0, 0, 10, 0, time.UTC),
+			time:   Time{Valid: true, Hour: 23, Minute: 59, Second: 58},
+			expect: time.Date(2020, 2, 29, 23, 59, 58, 0, time.UTC),
+		},
+		{
+			name:   "next day",
+			ref:    time.Date(2020, 12, 31, 23, 59, 50, 0, time.UTC),
+			time:   Time{Valid: true, Second: 3},
+			expect: time.Date(2021, 1, 1, 0, 0, 3, 0, time.UTC),
+		},
+		{
+			name:   "leap second",
+			ref:    time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC),
+			time:   Time{Valid: true, Hour: 23, Minute: 59, Second: 60},
+			expect: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := TimeOfDay(tc.ref, tc.time)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, result)
+		})
+	}
+
+	_, err := TimeOfDay(time.Time{}, Time{})
+	assert.EqualError(t, err, "nmea: time is not valid")
+}
+
+func TestCorrectGPSWeekRollover(t *testing.T) {
+	ref := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	corrected, ok := CorrectGPSWeekRollover(time.Date(1999, 10, 6, 0, 0, 0, 0, time.UTC), ref)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, 5, 22, 0, 0, 0, 0, time.UTC), corrected)
+
+	recent := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	corrected, ok = CorrectGPSWeekRollover(recent, ref)
+	assert.False(t, ok)
+	assert.Equal(t, recent, corrected)
+
+	// corrected time would be more than 30 days from the reference time
+	old := time.Date(1999, 3, 1, 0, 0, 0, 0, time.UTC)
+	corrected, ok = CorrectGPSWeekRollover(old, ref)
+	assert.False(t, ok)
+	assert.Equal(t, old, corrected)
+}
+
+func TestTimestamper(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		raw    string
+		ref    time.Time
+		expect time.Time
+	}{
+		{
+			name:   "RMC",
+			raw:    "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
+			ref:    time.Date(1994, 6, 14, 0, 0, 0, 0, time.UTC),
+			expect: time.Date(1994, 6, 13, 22, 5, 16, 0, time.UTC),
+		},
+		{
+			name:   "RMC with GPS week rollover",
+			raw:    "$GPRMC,225446,A,4916.45,N,12311.12,W,000.5,054.7,061099,020.3,E*6A",
+			ref:    time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+			expect: time.Date(2019, 5, 22, 22, 54, 46, 0, time.UTC),
+		},
+		{
+			name:   "GGA before midnight",
+			raw:    "$GPGGA,235958,4916.45,N,12311.12,W,1,08,0.9,545.4,M,46.9,M,,*52",
+			ref:    time.Date(2020, 1, 2, 0, 0, 1, 0, time.UTC),
+			expect: time.Date(2020, 1, 1, 23, 59, 58, 0, time.UTC),
+		},
+		{
+			name:   "GLL",
+			raw:    "$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58",
+			ref:    time.Date(2020, 1, 2, 3, 0, 0, 0, time.UTC),
+			expect: time.Date(2020, 1, 2, 2, 27, 32, 0, time.UTC),
+		},
+		{
+			name:   "GNS",
+			raw:    "$GPGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138*15",
+			ref:    time.Date(2020, 1, 2, 22, 0, 0, 0, time.UTC),
+			expect: time.Date(2020, 1, 2, 22, 47, 49, 0, time.UTC),
+		},
+		{
+			name:   "ZDA",
+			raw:    "$GPZDA,172809.456,12,07,1996,00,00*57",
+			ref:    time.Date(1996, 7, 12, 0, 0, 0, 0, time.UTC),
+			expect: time.Date(1996, 7, 12, 17, 28, 9, 456e6, time.UTC),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.raw)
+			assert.NoError(t, err)
+			ts, ok := s.(Timestamper)
+			if !assert.True(t, ok) {
+				return
+			}
+			result, err := ts.Timestamp(tc.ref)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, result)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DatumWGS84 is IHO datum code of WGS-84
+	DatumWGS84 = "W84"
+	// DatumWGS72 is IHO datum code of WGS-72
+	DatumWGS72 = "W72"
+	// DatumSGS85 is IHO datum code of Soviet Geodetic System 1985. SGS-85 deliberately has no built-in parameters, as
+	// there are no transformation parameters to WGS-84 from a source this package can cite. DTM sentences with S85
+	// and without offsets are rejected as unknown datum unless parameters are set in DatumTransformer.Datums.
+	DatumSGS85 = "S85"
+	// DatumPZ90 is IHO datum code of Parametry Zemli 1990 (GLONASS)
+	DatumPZ90 = "P90"
+	// DatumUserDefined is IHO datum code of user defined datum
+	DatumUserDefined = "999"
+
+	// arcSecond is one arc second in radians
+	arcSecond = math.Pi / (180 * 3600)
+)
+
+// Ellipsoid is reference ellipsoid of a datum
+type Ellipsoid struct {
+	SemiMajorAxis float64 // semi-major axis in metres
+	Flattening    float64
+}
+
+// WGS84Ellipsoid is the ellipsoid of WGS-84
+var WGS84Ellipsoid = Ellipsoid{SemiMajorAxis: WGS84SemiMajorAxis, Flattening: WGS84Flattening}
+
+// Helmert is 7-parameter Helmert transformation of geocentric coordinates in position vector convention (EPSG 9606)
+type Helmert struct {
+	TX, TY, TZ float64 // translation in metres
+	RX, RY, RZ float64 // rotation in arc seconds
+	Scale      float64 // scale difference in ppm
+}
+
+// Datum is geodetic datum with parameters of transformation to WGS-84
+type Datum struct {
+	Code           string // IHO datum code
+	Name           string
+	Ellipsoid      Ellipsoid
+	Transformation Helmert // transformation from the datum to WGS-84
+}
+
+// datums contains built-in datums by IHO datum code
+var datums = map[string]Datum{
+	DatumWGS84: {
+		Code:      DatumWGS84,
+		Name:      "WGS 84",
+		Ellipsoid: WGS84Ellipsoid,
+	},
+	DatumWGS72: {
+		Code:           DatumWGS72,
+		Name:           "WGS 72",
+		Ellipsoid:      Ellipsoid{SemiMajorAxis: 6378135, Flattening: 1 / 298.26},
+		Transformation: Helmert{TZ: 4.5, RZ: 0.554, Scale: 0.2263}, // EPSG 1237
+	},
+	DatumPZ90: {
+		Code:           DatumPZ90,
+		Name:           "PZ-90",
+		Ellipsoid:      Ellipsoid{SemiMajorAxis: 6378136, Flattening: 1 / 298.257839303},
+		Transformation: Helmert{TX: -1.08, TY: -0.27, TZ: -0.9, RZ: -0.16, Scale: -0.12}, // GOST R 51794-2001
+	},
+}
+
+// LookupDatum returns built-in datum by IHO datum code (W84, W72, P90)
+func LookupDatum(code string) (Datum, bool) {
+	d, ok := datums[code]
+	return d, ok
+}
+
+// ToWGS84 transforms position and ellipsoidal height (metres) in the datum to WGS-84
+func (d Datum) ToWGS84(p Position, height float64) (Position, float64) {
+	x, y, z := d.Ellipsoid.toECEF(p, height)
+	x, y, z = d.Transformation.apply(x, y, z)
+	return WGS84Ellipsoid.fromECEF(x, y, z)
+}
+
+// FromWGS84 transforms WGS-84 position and ellipsoidal height (metres) to the datum with exact inverse of the Helmert
+// transformation.
+func (d Datum) FromWGS84(p Position, height float64) (Position, float64) {
+	x, y, z := WGS84Ellipsoid.toECEF(p, height)
+	x, y, z = d.Transformation.applyInverse(x, y, z)
+	return d.Ellipsoid.fromECEF(x, y, z)
+}
+
+func (h Helmert) apply(x, y, z float64) (float64, float64, float64) {
+	s := 1 + h.Scale*1e-6
+	rx, ry, rz := h.RX*arcSecond, h.RY*arcSecond, h.RZ*arcSecond
+	return h.TX + s*(x-rz*y+ry*z),
+		h.TY + s*(rz*x+y-rx*z),
+		h.TZ + s*(-ry*x+rx*y+z)
+}
+
+// applyInverse solves x, y, z from the result of apply: removes translation and scale and multiplies with inverse of
+// the rotation matrix.
+func (h Helmert) applyInverse(x, y, z float64) (float64, float64, float64) {
+	s := 1 + h.Scale*1e-6
+	rx, ry, rz := h.RX*arcSecond, h.RY*arcSecond, h.RZ*arcSecond
+	x, y, z = (x-h.TX)/s, (y-h.TY)/s, (z-h.TZ)/s
+	// rotation matrix is [1 -rz ry; rz 1 -rx; -ry rx 1], its inverse is adjugate divided by determinant
+	det := 1 + rx*rx + ry*ry + rz*rz
+	return ((1+rx*rx)*x + (rz+rx*ry)*y + (rx*rz-ry)*z) / det,
+		((rx*ry-rz)*x + (1+ry*ry)*y + (rx+ry*rz)*z) / det,
+		((ry+rx*rz)*x + (ry*rz-rx)*y + (1+rz*rz)*z) / det
+}
+
+// toECEF converts geodetic position and height to geocentric (earth-centered, earth-fixed) coordinates
+func (e Ellipsoid) toECEF(p Position, height float64) (float64, float64, float64) {
+	e2 := e.Flattening * (2 - e.Flattening)
+	sinLat, cosLat := math.Sincos(toRadians(p.Latitude))
+	sinLon, cosLon := math.Sincos(toRadians(p.Longitude))
+	n := e.SemiMajorAxis / math.Sqrt(1-e2*sinLat*sinLat)
+	return (n + height) * cosLat * cosLon,
+		(n + height) * cosLat * sinLon,
+		(n*(1-e2) + height) * sinLat
+}
+
+// fromECEF converts geocentric coordinates to geodetic position and height
+func (e Ellipsoid) fromECEF(x, y, z float64) (Position, float64) {
+	e2 := e.Flattening * (2 - e.Flattening)
+	p := math.Hypot(x, y)
+	lon := math.Atan2(y, x)
+	lat := math.Atan2(z, p*(1-e2))
+	var height float64
+	for i := 0; i < 10; i++ {
+		sinLat := math.Sin(lat)
+		n := e.SemiMajorAxis / math.Sqrt(1-e2*sinLat*sinLat)
+		height = p/math.Cos(lat) - n
+		previous := lat
+		lat = math.Atan2(z, p*(1-e2*n/(n+height)))
+		if math.Abs(lat-previous) < 1e-14 {
+			break
+		}
+	}
+	return Position{Latitude: toDegrees(lat), Longitude: toDegrees(lon)}, height
+}
+
+// Positioner is implemented by sentences that contain own position (GGA, RMC, GLL, GNS, ...)
+type Positioner interface {
+	Sentence
+	Position() Position
+}
+
+// DatumTransformer converts positions of sentences to WGS-84 using datum reported in DTM sentences and expresses
+// WGS-84 positions in other datums.
+//
+// DatumTransformer is not safe for concurrent use.
+type DatumTransformer struct {
+	// Datums contains additional datums by IHO datum code, e.g. parameters of user defined datum (999). Datums
+	// override built-in datums with the same code.
+	Datums map[string]Datum
+	// Talker is talker ID of DTM sentences created by FromWGS84, "GP" when empty
+	Talker string
+
+	dtm map[string]DTM
+}
+
+// Update remembers DTM sentence as current datum of its talker. Other sentences are ignored.
+func (t *DatumTransformer) Update(s Sentence) {
+	dtm, ok := s.(DTM)
+	if !ok {
+		return
+	}
+	if t.dtm == nil {
+		t.dtm = map[string]DTM{}
+	}
+	t.dtm[dtm.Talker] = dtm
+}
+
+// ToWGS84 returns WGS-84 position and height of the sentence using the latest DTM sentence of the same talker.
+// Height is ellipsoidal height of the position in metres (e.g. GGA Altitude + Separation), 0 when it is not known.
+// Positions of talkers without DTM sentence are considered to be WGS-84.
+func (t *DatumTransformer) ToWGS84(s Positioner, height float64) (Position, float64, error) {
+	dtm, ok := t.dtm[s.TalkerID()]
+	if !ok {
+		return s.Position(), height, nil
+	}
+	return t.LocalToWGS84(dtm, s.Position(), height)
+}
+
+// LocalToWGS84 converts position and height (metres) in local datum of the DTM sentence to WGS-84. Offsets of the
+// DTM sentence are local datum position and altitude minus reference datum position and altitude. When DTM has no
+// offsets, parameters of the local datum code are used.
+func (t *DatumTransformer) LocalToWGS84(dtm DTM, p Position, height float64) (Position, float64, error) {
+	if dtm.DatumName != "" && dtm.DatumName != DatumWGS84 {
+		return Position{}, 0, fmt.Errorf("nmea: unsupported reference datum %q", dtm.DatumName)
+	}
+	if dtm.LatitudeOffsetMinute != 0 || dtm.LongitudeOffsetMinute != 0 || dtm.AltitudeOffsetMeters != 0 {
+		return Position{
+			Latitude:  p.Latitude - dtm.LatitudeOffsetMinute/60,
+			Longitude: normalizeLongitude(p.Longitude - dtm.LongitudeOffsetMinute/60),
+		}, height - dtm.AltitudeOffsetMeters, nil
+	}
+	if dtm.LocalDatumCode == "" || dtm.LocalDatumCode == DatumWGS84 {
+		return p, height, nil
+	}
+	d, ok := t.datum(dtm.LocalDatumCode)
+	if !ok {
+		return Position{}, 0, fmt.Errorf("nmea: unknown datum %q", dtm.LocalDatumCode)
+	}
+	result, height := d.ToWGS84(p, height)
+	return result, height, nil
+}
+
+// FromWGS84 expresses WGS-84 position in the datum and returns DTM sentence describing the datum with offsets from
+// WGS-84.
+func (t *DatumTransformer) FromWGS84(p Position, code string) (Position, DTM, error) {
+	talker := t.Talker
+	if talker == "" {
+		talker = "GP"
+	}
+	dtm := DTM{
+		BaseSentence:   BaseSentence{Talker: talker, Type: TypeDTM},
+		LocalDatumCode: code,
+		DatumName:      DatumWGS84,
+	}
+	if code == DatumWGS84 {
+		return p, dtm, nil
+	}
+	d, ok := t.datum(code)
+	if !ok {
+		return Position{}, DTM{}, fmt.Errorf("nmea: unknown datum %q", code)
+	}
+	local, height := d.FromWGS84(p, 0)
+	// offsets are rounded to millimetre level
+	dtm.LatitudeOffsetMinute = roundTo((local.Latitude-p.Latitude)*60, 1e6)
+	dtm.LongitudeOffsetMinute = roundTo(normalizeLongitude(local.Longitude-p.Longitude)*60, 1e6)
+	dtm.AltitudeOffsetMeters = roundTo(height, 1e3)
+	return local, dtm, nil
+}
+
+func (t *DatumTransformer) datum(code string) (Datum, bool) {
+	if d, ok := t.Datums[code]; ok {
+		return d, true
+	}
+	return LookupDatum(code)
+}
+
+// roundTo rounds value to precision of 1/factor
+func roundTo(v float64, factor float64) float64 {
+	return math.Round(v*factor) / factor
+}
@@ -0,0 +1,205 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const arcSecondDegrees = 1.0 / 3600
+
+func TestDatum_WGS72(t *testing.T) {
+	d, ok := LookupDatum(DatumWGS72)
+	assert.True(t, ok)
+
+	// DMA TR 8350.2: WGS-72 to WGS-84 longitude shift is +0.554", latitude shift at equator is 4.5 m to north and
+	// height shift is -0.6 m
+	p, height := d.ToWGS84(Position{}, 0)
+	assert.InDelta(t, 0.554*arcSecondDegrees, p.Longitude, 0.0001*arcSecondDegrees)
+	assert.InDelta(t, 0.1465*arcSecondDegrees, p.Latitude, 0.001*arcSecondDegrees)
+	assert.InDelta(t, -0.6, height, 0.05)
+
+	back, height := d.FromWGS84(p, height)
+	assert.InDelta(t, 0, back.Latitude, 1e-9)
+	assert.InDelta(t, 0, back.Longitude, 1e-9)
+	assert.InDelta(t, 0, height, 0.001)
+}
+
+func TestDatum_RoundTrip(t *testing.T) {
+	p := Position{Latitude: 59.437, Longitude: 24.745}
+	for _, code := range []string{DatumWGS84, DatumWGS72, DatumPZ90} {
+		t.Run(code, func(t *testing.T) {
+			d, ok := LookupDatum(code)
+			assert.True(t, ok)
+			local, height := d.FromWGS84(p, 25)
+			wgs84, height := d.ToWGS84(local, height)
+			assert.InDelta(t, p.Latitude, wgs84.Latitude, 1e-12)
+			assert.InDelta(t, p.Longitude, wgs84.Longitude, 1e-12)
+			assert.InDelta(t, 25, height, 1e-6)
+		})
+	}
+	_, ok := LookupDatum(DatumUserDefined)
+	assert.False(t, ok)
+	_, ok = LookupDatum(DatumSGS85)
+	assert.False(t, ok)
+}
+
+func TestDatum_InverseOfLargeRotation(t *testing.T) {
+	// inverse is exact, negated parameters would not pass these tolerances with large rotations
+	d := Datum{
+		Ellipsoid:      WGS84Ellipsoid,
+		Transformation: Helmert{TX: 120, TY: -35, TZ: 80, RX: 60, RY: -45, RZ: 90, Scale: 25},
+	}
+	p := Position{Latitude: -33.85, Longitude: 151.2}
+	local, height := d.FromWGS84(p, 100)
+	wgs84, height := d.ToWGS84(local, height)
+	assert.InDelta(t, p.Latitude, wgs84.Latitude, 1e-12)
+	assert.InDelta(t, p.Longitude, wgs84.Longitude, 1e-12)
+	assert.InDelta(t, 100, height, 1e-6)
+}
+
+func TestDatumTransformer_ToWGS84(t *testing.T) {
+	gga := "$GPGGA,000000,5321.6000,N,00630.0000,W,1,08,0.9,545.4,M,46.9,M,,*5C"
+	// ellipsoidal height of the GGA position: altitude 545.4 m + geoid separation 46.9 m
+	height := 592.3
+	w72, _ := LookupDatum(DatumWGS72)
+	expectW72, expectW72Height := w72.ToWGS84(Position{Latitude: 53.36, Longitude: -6.5}, height)
+
+	var testCases = []struct {
+		name         string
+		transformer  DatumTransformer
+		dtm          string
+		expect       Position
+		expectHeight float64
+		expectErr    string
+	}{
+		{
+			name:         "no DTM",
+			expect:       Position{Latitude: 53.36, Longitude: -6.5},
+			expectHeight: height,
+		},
+		{
+			name:         "WGS-84",
+			dtm:          "$GPDTM,W84,,0.0,N,0.0,E,0.0,W84*6F",
+			expect:       Position{Latitude: 53.36, Longitude: -6.5},
+			expectHeight: height,
+		},
+		{
+			name:         "DTM offsets",
+			dtm:          "$GPDTM,999,,0.08,N,0.07,E,-47.7,W84*1B",
+			expect:       Position{Latitude: 53.36 - 0.08/60, Longitude: -6.5 - 0.07/60},
+			expectHeight: height + 47.7,
+		},
+		{
+			name:         "DTM altitude offset only",
+			dtm:          "$GPDTM,999,,0.0,N,0.0,E,2.5,W84*0A",
+			expect:       Position{Latitude: 53.36, Longitude: -6.5},
+			expectHeight: height - 2.5,
+		},
+		{
+			name:         "built-in datum",
+			dtm:          "$GPDTM,W72,,,,,,,W84*43",
+			expect:       expectW72,
+			expectHeight: expectW72Height,
+		},
+		{
+			name:         "user defined datum",
+			transformer:  DatumTransformer{Datums: map[string]Datum{DatumWGS72: {Code: DatumWGS72, Ellipsoid: WGS84Ellipsoid}}},
+			dtm:          "$GPDTM,W72,,,,,,,W84*43",
+			expect:       Position{Latitude: 53.36, Longitude: -6.5},
+			expectHeight: height,
+		},
+		{
+			name:      "unsupported reference datum",
+			dtm:       "$GPDTM,P90,,,,,,,P90*4A",
+			expectErr: `nmea: unsupported reference datum "P90"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transformer := tc.transformer
+			if tc.dtm != "" {
+				s, err := Parse(tc.dtm)
+				assert.NoError(t, err)
+				transformer.Update(s)
+			}
+			s, err := Parse(gga)
+			assert.NoError(t, err)
+			transformer.Update(s)
+
+			p, h, err := transformer.ToWGS84(s.(Positioner), height)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.expect.Latitude, p.Latitude, 1e-9)
+			assert.InDelta(t, tc.expect.Longitude, p.Longitude, 1e-9)
+			assert.InDelta(t, tc.expectHeight, h, 1e-6)
+		})
+	}
+}
+
+func TestDatumTransformer_UnknownDatum(t *testing.T) {
+	transformer := DatumTransformer{}
+	_, _, err := transformer.LocalToWGS84(DTM{LocalDatumCode: DatumUserDefined, DatumName: DatumWGS84}, Position{}, 0)
+	assert.EqualError(t, err, `nmea: unknown datum "999"`)
+
+	_, _, err = transformer.FromWGS84(Position{}, "XXX")
+	assert.EqualError(t, err, `nmea: unknown datum "XXX"`)
+}
+
+func TestDatumTransformer_SGS85(t *testing.T) {
+	p := Position{Latitude: 55.75, Longitude: 37.6}
+	dtm := DTM{LocalDatumCode: DatumSGS85, DatumName: DatumWGS84}
+
+	// SGS-85 has no built-in parameters
+	transformer := DatumTransformer{}
+	_, _, err := transformer.LocalToWGS84(dtm, p, 0)
+	assert.EqualError(t, err, `nmea: unknown datum "S85"`)
+	_, _, err = transformer.FromWGS84(p, DatumSGS85)
+	assert.EqualError(t, err, `nmea: unknown datum "S85"`)
+
+	// offsets of DTM are used without parameters
+	withOffsets := dtm
+	withOffsets.LatitudeOffsetMinute = 0.06
+	wgs84, _, err := transformer.LocalToWGS84(withOffsets, p, 0)
+	assert.NoError(t, err)
+	assert.InDelta(t, 55.749, wgs84.Latitude, 1e-12)
+
+	// parameters can be set by user
+	transformer.Datums = map[string]Datum{DatumSGS85: {Code: DatumSGS85, Ellipsoid: WGS84Ellipsoid}}
+	wgs84, _, err = transformer.LocalToWGS84(dtm, p, 0)
+	assert.NoError(t, err)
+	assert.InDelta(t, p.Latitude, wgs84.Latitude, 1e-12)
+}
+
+func TestDatumTransformer_FromWGS84(t *testing.T) {
+	transformer := DatumTransformer{Talker: "GN"}
+	p := Position{Latitude: 0, Longitude: 0}
+
+	local, dtm, err := transformer.FromWGS84(p, DatumWGS72)
+	assert.NoError(t, err)
+	assert.InDelta(t, -0.554*arcSecondDegrees, local.Longitude, 0.0001*arcSecondDegrees)
+	assert.InDelta(t, local.Latitude*60, dtm.LatitudeOffsetMinute, 1e-6)
+	assert.InDelta(t, local.Longitude*60, dtm.LongitudeOffsetMinute, 1e-6)
+	assert.InDelta(t, 0.6, dtm.AltitudeOffsetMeters, 0.05)
+
+	raw, err := Encode(dtm)
+	assert.NoError(t, err)
+	assert.Equal(t, "$GNDTM,W72,,0.002442,S,0.009233,W,0.557,W84*7B", raw)
+
+	// generated DTM converts local position back to WGS-84
+	wgs84, height, err := transformer.LocalToWGS84(dtm, local, dtm.AltitudeOffsetMeters)
+	assert.NoError(t, err)
+	assert.InDelta(t, p.Latitude, wgs84.Latitude, 1e-7)
+	assert.InDelta(t, p.Longitude, wgs84.Longitude, 1e-7)
+	assert.InDelta(t, 0, height, 1e-9)
+
+	same, dtm, err := transformer.FromWGS84(p, DatumWGS84)
+	assert.NoError(t, err)
+	assert.Equal(t, p, same)
+	raw, err = Encode(dtm)
+	assert.NoError(t, err)
+	assert.Equal(t, "$GNDTM,W84,,0,N,0,E,0,W84*6F", raw)
+}
@@ -23,7 +23,7 @@ type DBK struct {
 
 // newDBK constructor
 func newDBK(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDBK)
 	return DBK{
 		BaseSentence:     s,
@@ -35,3 +35,21 @@ func newDBK(s BaseSentence) (Sentence, error) {
 		DepthFathomsUnit: p.EnumString(5, "depth fathom unit", DistanceUnitFathom),
 	}, p.Err()
 }
+
+// Depth returns depth below keel. Depth in metres is preferred, feet and fathoms are used when metres are not
+// available. Returns false when depth is missing.
+func (s DBK) Depth() (Depth, bool) {
+	return depthOf(s.BaseSentence, s.DepthFeet, s.DepthMeters, s.DepthFathoms)
+}
+
+// EncodeFields returns the DBK sentence fields in wire format
+func (s DBK) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.DepthFeet, 3)
+	e.String(s.DepthFeetUnit)
+	e.Float64(s.DepthMeters, 3)
+	e.String(s.DepthMetersUnit)
+	e.Float64(s.DepthFathoms, 3)
+	e.String(s.DepthFathomsUnit)
+	return e.Fields()
+}
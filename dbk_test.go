@@ -55,3 +55,17 @@ func TestDBK(t *testing.T) {
 		})
 	}
 }
+
+func TestDBK_Depth(t *testing.T) {
+	s, err := Parse("$SDDBK,12.3,f,3.7,M,2.0,F*2F")
+	assert.NoError(t, err)
+	depth, ok := s.(DBK).Depth()
+	assert.True(t, ok)
+	assert.Equal(t, Depth{Value: 3.7, Unit: DistanceUnitMetre}, depth)
+
+	s, err = Parse("$SDDBK,,f,,M,001.2,F*1A")
+	assert.NoError(t, err)
+	depth, ok = s.(DBK).Depth()
+	assert.True(t, ok)
+	assert.Equal(t, Depth{Value: 1.2, Unit: DistanceUnitFathom}, depth)
+}
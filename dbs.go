@@ -23,7 +23,7 @@ type DBS struct {
 
 // newDBS constructor
 func newDBS(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDBS)
 	return DBS{
 		BaseSentence:    s,
@@ -35,3 +35,21 @@ func newDBS(s BaseSentence) (Sentence, error) {
 		DepthFathomUnit: p.EnumString(5, "depth fathom unit", DistanceUnitFathom),
 	}, p.Err()
 }
+
+// Depth returns depth below surface. Depth in metres is preferred, feet and fathoms are used when metres are not
+// available. Returns false when depth is missing.
+func (s DBS) Depth() (Depth, bool) {
+	return depthOf(s.BaseSentence, s.DepthFeet, s.DepthMeters, s.DepthFathoms)
+}
+
+// EncodeFields returns the DBS sentence fields in wire format
+func (s DBS) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.DepthFeet, 3)
+	e.String(s.DepthFeetUnit)
+	e.Float64(s.DepthMeters, 3)
+	e.String(s.DepthMeterUnit)
+	e.Float64(s.DepthFathoms, 3)
+	e.String(s.DepthFathomUnit)
+	return e.Fields()
+}
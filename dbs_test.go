@@ -59,3 +59,11 @@ func TestDBS(t *testing.T) {
 		})
 	}
 }
+
+func TestDBS_Depth(t *testing.T) {
+	s, err := Parse("$SDDBS,,,0187.5,M,,*1A")
+	assert.NoError(t, err)
+	depth, ok := s.(DBS).Depth()
+	assert.True(t, ok)
+	assert.Equal(t, Depth{Value: 187.5, Unit: DistanceUnitMetre}, depth)
+}
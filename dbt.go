@@ -19,7 +19,7 @@ type DBT struct {
 
 // newDBT constructor
 func newDBT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDBT)
 	return DBT{
 		BaseSentence: s,
@@ -28,3 +28,21 @@ func newDBT(s BaseSentence) (Sentence, error) {
 		DepthFathoms: p.Float64(4, "depth_fathoms"),
 	}, p.Err()
 }
+
+// Depth returns depth below transducer. Depth in metres is preferred, feet and fathoms are used when metres are not
+// available. Returns false when depth is missing.
+func (s DBT) Depth() (Depth, bool) {
+	return depthOf(s.BaseSentence, s.DepthFeet, s.DepthMeters, s.DepthFathoms)
+}
+
+// EncodeFields returns the DBT sentence fields in wire format
+func (s DBT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.DepthFeet, 3)
+	e.Fixed(DistanceUnitFeet)
+	e.Float64(s.DepthMeters, 3)
+	e.Fixed(DistanceUnitMetre)
+	e.Float64(s.DepthFathoms, 3)
+	e.Fixed(DistanceUnitFathom)
+	return e.Fields()
+}
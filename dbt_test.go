@@ -44,3 +44,35 @@ func TestDBT(t *testing.T) {
 		})
 	}
 }
+
+func TestDBT_Depth(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		raw      string
+		expect   Depth
+		expectOK bool
+	}{
+		{name: "metres", raw: "$IIDBT,032.93,f,010.04,M,005.42,F*2C", expect: Depth{Value: 10.04, Unit: DistanceUnitMetre}, expectOK: true},
+		{name: "feet only", raw: "$SDDBT,032.93,f,,M,,F*3D", expect: Depth{Value: 32.93, Unit: DistanceUnitFeet}, expectOK: true},
+		{name: "zero depth", raw: "$SDDBT,0.0,f,0.0,M,0.0,F*06", expect: Depth{Value: 0, Unit: DistanceUnitMetre}, expectOK: true},
+		{name: "missing", raw: "$SDDBT,,f,,M,,F*28", expect: Depth{}, expectOK: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.raw)
+			assert.NoError(t, err)
+			depth, ok := s.(DBT).Depth()
+			assert.Equal(t, tc.expectOK, ok)
+			assert.Equal(t, tc.expect, depth)
+		})
+	}
+}
+
+func TestDBT_DepthWithoutFields(t *testing.T) {
+	depth, ok := DBT{DepthFeet: 32.93}.Depth()
+	assert.True(t, ok)
+	assert.Equal(t, Depth{Value: 32.93, Unit: DistanceUnitFeet}, depth)
+
+	_, ok = DBT{}.Depth()
+	assert.False(t, ok)
+}
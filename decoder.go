@@ -0,0 +1,85 @@
+package nmea
+
+import (
+	"unsafe"
+)
+
+// Decodable is implemented by pointers to sentence types that can be parsed into an existing value. Decoding
+// reuses memory (slices) of the existing value. GGA, RMC and VDMVDO implement Decodable.
+type Decodable interface {
+	Sentence
+	// Decode parses base sentence into the value
+	Decode(s BaseSentence) error
+}
+
+// Decoder parses sentences from byte slices and is meant for high-rate feeds. Decoder reuses its internal buffers
+// between calls so splitting sentence into fields does not allocate memory. Combined with DecodeInto and reused
+// sentence value, parsing GGA, RMC and VDM sentences does not allocate at all.
+//
+// Strings of the BaseSentence (Raw, Fields etc.) returned by Decoder share memory with the Decoder buffer and are
+// valid only until the next call to Decoder. Copy strings that need to be kept longer.
+//
+// Decoder is not safe for concurrent use by multiple goroutines. Use separate Decoder for each goroutine.
+type Decoder struct {
+	// Parser is used to parse sentences. When nil, parser used by the package level Parse function is used.
+	Parser *SentenceParser
+
+	buf    []byte
+	fields []string
+	base   BaseSentence
+}
+
+func (d *Decoder) parser() *SentenceParser {
+	if d.Parser != nil {
+		return d.Parser
+	}
+	return &defaultSentenceParser
+}
+
+// DecodeBase parses raw sentence into BaseSentence.
+func (d *Decoder) DecodeBase(raw []byte) (BaseSentence, error) {
+	p := d.parser()
+	d.buf = append(d.buf[:0], raw...)
+	s, err := p.parseBaseSentenceInto(bytesToString(d.buf), &d.fields)
+	if err != nil {
+		return BaseSentence{}, err
+	}
+	d.base = s
+	if err := p.prepareBaseSentence(&d.base); err != nil {
+		return BaseSentence{}, err
+	}
+	return d.base, nil
+}
+
+// Decode parses raw sentence into the correct sentence type. Sentence value is allocated by the sentence type
+// parser, use DecodeInto to reuse existing sentence value.
+func (d *Decoder) Decode(raw []byte) (Sentence, error) {
+	s, err := d.DecodeBase(raw)
+	if err != nil {
+		return nil, err
+	}
+	return d.parser().parseSentence(s)
+}
+
+// DecodeInto parses raw sentence into dst. Sentence type of raw sentence must match the type of dst.
+//
+// Example:
+//
+//	var gga nmea.GGA
+//	err := decoder.DecodeInto(line, &gga)
+func (d *Decoder) DecodeInto(raw []byte, dst Decodable) error {
+	s, err := d.DecodeBase(raw)
+	if err != nil {
+		return err
+	}
+	return d.parser().fieldErrors(dst.Decode(s))
+}
+
+// bytesToString converts byte slice to string without copying. Byte slice must not be modified while the string
+// is in use.
+func bytesToString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return *(*string)(unsafe.Pointer(&b))
+}
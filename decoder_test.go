@@ -0,0 +1,160 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	decoderGGA = "$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C"
+	decoderRMC = "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C"
+	decoderVDM = "!AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25"
+)
+
+func TestDecoder_Decode(t *testing.T) {
+	var tests = []struct {
+		name string
+		raw  string
+		err  string
+	}{
+		{name: "GGA", raw: decoderGGA},
+		{name: "RMC", raw: decoderRMC},
+		{name: "VDM", raw: decoderVDM},
+		{name: "tag block", raw: "\\s:Satelite_1,c:1553390539*62\\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl`K6`nV00Sv,0*52"},
+		{name: "bad checksum", raw: "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0D", err: "nmea: sentence checksum mismatch [0C != 0D]"},
+		{name: "unknown type", raw: "$GPFOO,1,2,3.3,x,y,zz,*51", err: "nmea: sentence prefix 'GPFOO' not supported"},
+	}
+	var d Decoder
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := d.Decode([]byte(tt.raw))
+			expected, expectedErr := Parse(tt.raw)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.EqualError(t, expectedErr, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expected, s)
+		})
+	}
+}
+
+func TestDecoder_DecodeInto(t *testing.T) {
+	var d Decoder
+	var gga GGA
+	assert.NoError(t, d.DecodeInto([]byte(decoderGGA), &gga))
+	expected, err := Parse(decoderGGA)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, gga)
+
+	var rmc RMC
+	assert.NoError(t, d.DecodeInto([]byte(decoderRMC), &rmc))
+	expected, err = Parse(decoderRMC)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, rmc)
+
+	var vdm VDMVDO
+	assert.NoError(t, d.DecodeInto([]byte(decoderVDM), &vdm))
+	expected, err = Parse(decoderVDM)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, vdm)
+
+	err = d.DecodeInto([]byte(decoderRMC), &gga)
+	assert.EqualError(t, err, "nmea: GPRMC invalid type: RMC")
+}
+
+func TestDecoder_BufferReuse(t *testing.T) {
+	var d Decoder
+	raw := []byte(decoderGGA)
+	s, err := d.DecodeBase(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "GN", s.Talker)
+
+	// modifying input must not change decoded sentence
+	raw[2] = 'P'
+	assert.Equal(t, "GN", s.Talker)
+	assert.Equal(t, decoderGGA, s.Raw)
+}
+
+func TestDecoder_CustomParser(t *testing.T) {
+	p := SentenceParser{
+		CustomParsers: map[string]ParserFunc{
+			"FOO": func(s BaseSentence) (Sentence, error) {
+				return s, nil
+			},
+		},
+	}
+	d := Decoder{Parser: &p}
+	s, err := d.Decode([]byte("$GPFOO,1,2,3.3,x,y,zz,*51"))
+	assert.NoError(t, err)
+	assert.Equal(t, "FOO", s.DataType())
+}
+
+func benchmarkDecodeInto(b *testing.B, raw string, dst Decodable) {
+	var d Decoder
+	line := []byte(raw)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := d.DecodeInto(line, dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkParse(b *testing.B, raw string) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(raw); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecoder_DecodeInto_GGA(b *testing.B) {
+	benchmarkDecodeInto(b, decoderGGA, &GGA{})
+}
+
+func BenchmarkDecoder_DecodeInto_RMC(b *testing.B) {
+	benchmarkDecodeInto(b, decoderRMC, &RMC{})
+}
+
+func BenchmarkDecoder_DecodeInto_VDM(b *testing.B) {
+	benchmarkDecodeInto(b, decoderVDM, &VDMVDO{})
+}
+
+func BenchmarkParse_GGA(b *testing.B) {
+	benchmarkParse(b, decoderGGA)
+}
+
+func BenchmarkParse_RMC(b *testing.B) {
+	benchmarkParse(b, decoderRMC)
+}
+
+func BenchmarkParse_VDM(b *testing.B) {
+	benchmarkParse(b, decoderVDM)
+}
+
+func TestDecoder_DecodeInto_ZeroAllocs(t *testing.T) {
+	var tests = []struct {
+		name string
+		raw  string
+		dst  Decodable
+	}{
+		{name: "GGA", raw: decoderGGA, dst: &GGA{}},
+		{name: "RMC", raw: decoderRMC, dst: &RMC{}},
+		{name: "VDM", raw: decoderVDM, dst: &VDMVDO{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decoder
+			line := []byte(tt.raw)
+			allocs := testing.AllocsPerRun(100, func() {
+				_ = d.DecodeInto(line, tt.dst)
+			})
+			assert.Equal(t, float64(0), allocs)
+		})
+	}
+}
@@ -80,7 +80,7 @@ type DOR struct {
 
 // newDOR constructor
 func newDOR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDOR)
 	return DOR{
 		BaseSentence:       s,
@@ -95,3 +95,18 @@ func newDOR(s BaseSentence) (Sentence, error) {
 		Message:            p.String(8, "message"),
 	}, p.Err()
 }
+
+// EncodeFields returns the DOR sentence fields in wire format
+func (s DOR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.Type)
+	e.Time(s.Time, "time")
+	e.String(s.SystemIndicator)
+	e.String(s.DivisionIndicator1)
+	e.Int64(s.DivisionIndicator2)
+	e.Int64(s.DoorNumberOrCount)
+	e.String(s.DoorStatus)
+	e.String(s.SwitchSetting)
+	e.String(s.Message)
+	return e.Fields()
+}
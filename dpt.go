@@ -20,7 +20,7 @@ type DPT struct {
 
 // newDPT constructor
 func newDPT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDPT)
 	dpt := DPT{
 		BaseSentence: s,
@@ -32,3 +32,25 @@ func newDPT(s BaseSentence) (Sentence, error) {
 	}
 	return dpt, p.Err()
 }
+
+// WaterDepth returns water depth relative to the transducer. Returns false when depth field is empty.
+func (s DPT) WaterDepth() (Depth, bool) {
+	return Depth{Value: s.Depth, Unit: DistanceUnitMetre}, valuePresent(s.BaseSentence, 0, s.Depth)
+}
+
+// TransducerOffset returns offset from the transducer: positive is distance from transducer to water line, negative is
+// distance from transducer to keel. Returns false when offset field is empty.
+func (s DPT) TransducerOffset() (Distance, bool) {
+	return Distance{Value: s.Offset, Unit: DistanceUnitMetre}, valuePresent(s.BaseSentence, 1, s.Offset)
+}
+
+// EncodeFields returns the DPT sentence fields in wire format
+func (s DPT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Depth, 3)
+	e.Float64(s.Offset, 3)
+	if len(s.Fields) > 2 || s.RangeScale != 0 {
+		e.Float64(s.RangeScale, 3)
+	}
+	return e.Fields()
+}
@@ -62,3 +62,29 @@ func TestDPT(t *testing.T) {
 		})
 	}
 }
+
+func TestDPT_Accessors(t *testing.T) {
+	s, err := Parse("$SDDPT,0.5,-0.2,*51")
+	assert.NoError(t, err)
+	dpt := s.(DPT)
+	depth, ok := dpt.WaterDepth()
+	assert.True(t, ok)
+	assert.Equal(t, Depth{Value: 0.5, Unit: DistanceUnitMetre}, depth)
+	offset, ok := dpt.TransducerOffset()
+	assert.True(t, ok)
+	assert.Equal(t, Distance{Value: -0.2, Unit: DistanceUnitMetre}, offset)
+
+	s, err = Parse("$SDDPT,,,*7B")
+	assert.NoError(t, err)
+	_, ok = s.(DPT).WaterDepth()
+	assert.False(t, ok)
+	_, ok = s.(DPT).TransducerOffset()
+	assert.False(t, ok)
+
+	// zero value is present in sentence without fields
+	depth, ok = DPT{Depth: 0.5}.WaterDepth()
+	assert.True(t, ok)
+	assert.Equal(t, Depth{Value: 0.5, Unit: DistanceUnitMetre}, depth)
+	_, ok = DPT{Depth: 0.5}.TransducerOffset()
+	assert.False(t, ok)
+}
@@ -106,7 +106,7 @@ type DSC struct {
 
 // newDSC constructor
 func newDSC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDSC)
 	return DSC{
 		BaseSentence:                s,
@@ -132,3 +132,24 @@ func newDSC(s BaseSentence) (Sentence, error) {
 		ExpansionIndicator: p.String(10, "expansion indicator"),
 	}, p.Err()
 }
+
+// EncodeFields returns the DSC sentence fields in wire format
+func (s DSC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.FormatSpecifier)
+	e.String(s.Address)
+	e.String(s.Category)
+	e.String(s.DistressCauseOrTeleCommand1)
+	e.String(s.CommandTypeOrTeleCommand2)
+	e.String(s.PositionOrCanal)
+	e.String(s.TimeOrTelephoneNumber)
+	e.String(s.MMSI)
+	e.String(s.DistressCause)
+	acknowledgement := s.Acknowledgement
+	if len(s.Fields) > 9 && strings.TrimSpace(s.Fields[9]) == acknowledgement {
+		acknowledgement = s.Fields[9] // keep original padding
+	}
+	e.String(acknowledgement)
+	e.String(s.ExpansionIndicator)
+	return e.Fields()
+}
@@ -49,7 +49,7 @@ type DSEDataSet struct {
 
 // newDSE constructor
 func newDSE(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDSE)
 	dse := DSE{
 		BaseSentence:    s,
@@ -76,3 +76,17 @@ func newDSE(s BaseSentence) (Sentence, error) {
 	}
 	return dse, p.Err()
 }
+
+// EncodeFields returns the DSE sentence fields in wire format
+func (s DSE) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.TotalNumber)
+	e.Int64(s.Number)
+	e.String(s.Acknowledgement)
+	e.String(s.MMSI)
+	for _, ds := range s.DataSets {
+		e.String(ds.Code)
+		e.String(ds.Data)
+	}
+	return e.Fields()
+}
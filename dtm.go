@@ -1,5 +1,7 @@
 package nmea
 
+import "math"
+
 const (
 	// TypeDTM type of DTM sentence for Datum Reference
 	TypeDTM = "DTM"
@@ -25,7 +27,7 @@ type DTM struct {
 
 // newDTM constructor
 func newDTM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeDTM)
 	m := DTM{
 		BaseSentence:      s,
@@ -46,3 +48,17 @@ func newDTM(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the DTM sentence fields in wire format
+func (s DTM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.LocalDatumCode)
+	e.String(s.LocalDatumSubcode)
+	e.Float64(math.Abs(s.LatitudeOffsetMinute), 6)
+	e.Direction(s.LatitudeOffsetMinute, North, South)
+	e.Float64(math.Abs(s.LongitudeOffsetMinute), 6)
+	e.Direction(s.LongitudeOffsetMinute, East, West)
+	e.Float64(s.AltitudeOffsetMeters, 3)
+	e.String(s.DatumName)
+	return e.Fields()
+}
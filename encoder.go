@@ -0,0 +1,437 @@
+package nmea
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Encoder is implemented by sentences that can be encoded back into NMEA0183 wire format.
+// All built-in sentence types implement Encoder. BaseSentence implements Encoder by returning its fields as they
+// were received, so custom sentence types embedding BaseSentence should implement EncodeFields to have changes to
+// their values encoded.
+type Encoder interface {
+	Sentence
+	// EncodeFields returns sentence data fields (address field excluded) formatted for wire format
+	EncodeFields() ([]string, error)
+}
+
+// baseSentencer allows access to BaseSentence of sentence that embeds it
+type baseSentencer interface {
+	baseSentence() BaseSentence
+}
+
+func (s BaseSentence) baseSentence() BaseSentence {
+	return s
+}
+
+// EncodeFields returns sentence fields as they were received
+func (s BaseSentence) EncodeFields() ([]string, error) {
+	return append([]string(nil), s.Fields...), nil
+}
+
+// Encode encodes the sentence into NMEA0183 wire format (start delimiter, address, fields and checksum) without
+// the <CR><LF> line terminator. Tag block is not included, see EncodeWithTagBlock. Reserved characters in fields
+// are escaped with `^hh` hex escape sequences so sentences parsed with SentenceParser.KeepEscapedFields must not
+// be encoded with their raw fields. Struct types declared with StructParser are encoded using their struct tags.
+//
+// Example: $GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51
+func Encode(s Sentence) (string, error) {
+	fields, err := encodeFields(s)
+	if err != nil {
+		return "", err
+	}
+	return encodeSentence(startDelimiter(s), address(s), fields), nil
+}
+
+// encodeFields returns sentence fields in wire format. Sentences of struct types declared with StructParser are
+// encoded using their struct tags.
+func encodeFields(s Sentence) ([]string, error) {
+	if c, v, ok := lookupStructCodec(s); ok {
+		return c.encodeFields(v)
+	}
+	enc, ok := s.(Encoder)
+	if !ok {
+		return nil, fmt.Errorf("nmea: sentence %s does not implement Encoder", s.Prefix())
+	}
+	return enc.EncodeFields()
+}
+
+// EncodeWithTagBlock encodes the sentence same way as Encode does but prepends the sentence tag block when the
+// sentence has one.
+//
+// Example: \s:Satelite_1,c:1553390539*62\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl`K6`nV00Sv,0*52
+func EncodeWithTagBlock(s Sentence) (string, error) {
+	raw, err := Encode(s)
+	if err != nil {
+		return "", err
+	}
+	if b, ok := s.(baseSentencer); ok {
+		return FormatTagBlock(b.baseSentence().TagBlock) + raw, nil
+	}
+	return raw, nil
+}
+
+// encodeSentence creates wire format sentence from given start delimiter, address and fields. Reserved characters
+// in fields are escaped.
+func encodeSentence(start, address string, fields []string) string {
+	var sb strings.Builder
+	sb.WriteString(address)
+	for _, f := range fields {
+		sb.WriteString(FieldSep)
+		sb.WriteString(EscapeField(f))
+	}
+	body := sb.String()
+	return start + body + ChecksumSep + Checksum(body)
+}
+
+// startDelimiter returns start delimiter of sentence. Parsed sentences keep their original start delimiter,
+// for new sentences the delimiter is chosen by sentence type.
+func startDelimiter(s Sentence) string {
+	if raw := s.String(); raw != "" && (raw[0] == SentenceStart[0] || raw[0] == SentenceStartEncapsulated[0]) {
+		return raw[:1]
+	}
+	return startDelimiterForType(s.DataType())
+}
+
+// address returns the address field (talker ID and sentence type) of the sentence
+func address(s Sentence) string {
+	if q, ok := s.(Query); ok {
+		return q.Talker + q.DestinationTalkerID + string(QuerySentencePostfix)
+	}
+	return s.Prefix()
+}
+
+// FieldEncoder provides a simple way of formatting sentence fields. Fields are appended in wire order.
+//
+// When FieldEncoder is created for a parsed sentence, fields whose value has not changed keep their original
+// representation (padding, precision etc) so that encoding a parsed sentence results in the received sentence.
+type FieldEncoder struct {
+	prefix   string
+	original []string
+	fields   []string
+	err      error
+}
+
+// NewFieldEncoder constructor
+func NewFieldEncoder(s BaseSentence) *FieldEncoder {
+	return &FieldEncoder{
+		prefix:   s.Prefix(),
+		original: s.Fields,
+		fields:   make([]string, 0, len(s.Fields)),
+	}
+}
+
+// Fields returns encoded fields and the first error encountered during encoding.
+func (e *FieldEncoder) Fields() ([]string, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	// trailing fields of the original sentence that are not known to the sentence type are kept as is
+	if len(e.original) > len(e.fields) {
+		e.fields = append(e.fields, e.original[len(e.fields):]...)
+	}
+	return e.fields, nil
+}
+
+// Err returns the first error encountered during the encoder's usage.
+func (e *FieldEncoder) Err() error {
+	return e.err
+}
+
+// SetErr assigns an error. Calling this method has no
+// effect if there is already an error.
+func (e *FieldEncoder) SetErr(context, value string) {
+	e.setFieldErr(&FieldError{Index: -1, Context: context, Value: value})
+}
+
+// setFieldErr assigns field error. Calling this method has no effect if there is already an error.
+func (e *FieldEncoder) setFieldErr(err *FieldError) {
+	if e.err == nil {
+		err.Prefix = e.prefix
+		e.err = err
+	}
+}
+
+// originalField returns original field value at offset from the next field index
+func (e *FieldEncoder) originalField(offset int) (string, bool) {
+	i := len(e.fields) + offset
+	if i < len(e.original) {
+		return e.original[i], true
+	}
+	return "", false
+}
+
+// String appends the string value as is.
+func (e *FieldEncoder) String(v string) {
+	e.fields = append(e.fields, v)
+}
+
+// Fixed appends field that has no value in sentence struct (for example constant unit of measurement field).
+// Original field value is kept, v is used when there is no original field.
+func (e *FieldEncoder) Fixed(v string) {
+	if o, ok := e.originalField(0); ok {
+		v = o
+	}
+	e.fields = append(e.fields, v)
+}
+
+// Bool appends trueValue or falseValue depending on v.
+func (e *FieldEncoder) Bool(v bool, trueValue, falseValue string) {
+	if o, ok := e.originalField(0); ok && (o == trueValue) == v {
+		e.fields = append(e.fields, o)
+		return
+	}
+	if v {
+		e.fields = append(e.fields, trueValue)
+		return
+	}
+	e.fields = append(e.fields, falseValue)
+}
+
+// Direction appends positive or negative direction symbol (for example East/West) depending on sign of v.
+func (e *FieldEncoder) Direction(v float64, positive, negative string) {
+	if o, ok := e.originalField(0); ok && (o == negative) == (v < 0) {
+		e.fields = append(e.fields, o)
+		return
+	}
+	if v < 0 {
+		e.fields = append(e.fields, negative)
+		return
+	}
+	e.fields = append(e.fields, positive)
+}
+
+// Int64 appends the int64 value.
+func (e *FieldEncoder) Int64(v int64) {
+	e.ZeroPaddedInt64(v, 0)
+}
+
+// ZeroPaddedInt64 appends the int64 value padded with zeros to given number of digits.
+func (e *FieldEncoder) ZeroPaddedInt64(v int64, digits int) {
+	if o, ok := e.originalField(0); ok {
+		if i, err := strconv.ParseInt(o, 10, 64); (err == nil && i == v) || (o == "" && v == 0) {
+			e.fields = append(e.fields, o)
+			return
+		}
+	}
+	e.fields = append(e.fields, fmt.Sprintf("%0*d", digits, v))
+}
+
+// OptionalInt64 appends the int64 value. Zero value is encoded as empty field unless the original field was zero.
+func (e *FieldEncoder) OptionalInt64(v int64) {
+	if o, ok := e.originalField(0); v == 0 && (!ok || o == "") {
+		e.fields = append(e.fields, "")
+		return
+	}
+	e.Int64(v)
+}
+
+// NullInt64 appends the Int64 value. Invalid value is encoded as empty field.
+func (e *FieldEncoder) NullInt64(v Int64) {
+	if !v.Valid {
+		e.fields = append(e.fields, "")
+		return
+	}
+	if o, ok := e.originalField(0); ok && o == "" {
+		// original empty field would be parsed as invalid value
+		e.fields = append(e.fields, strconv.FormatInt(v.Value, 10))
+		return
+	}
+	e.Int64(v.Value)
+}
+
+// HexInt64 appends the int64 value as upper case hex string padded with zeros to given number of digits.
+func (e *FieldEncoder) HexInt64(v int64, digits int) {
+	if o, ok := e.originalField(0); ok {
+		if i, err := strconv.ParseInt(o, 16, 64); (err == nil && i == v) || (o == "" && v == 0) {
+			e.fields = append(e.fields, o)
+			return
+		}
+	}
+	e.fields = append(e.fields, fmt.Sprintf("%0*X", digits, v))
+}
+
+// Hex appends the data as upper case hex string.
+func (e *FieldEncoder) Hex(data []byte) {
+	if o, ok := e.originalField(0); ok {
+		if b, err := hex.DecodeString(o); err == nil && bytes.Equal(b, data) {
+			e.fields = append(e.fields, o)
+			return
+		}
+	}
+	e.fields = append(e.fields, strings.ToUpper(hex.EncodeToString(data)))
+}
+
+// Float64 appends the float64 value rounded to given number of decimal places. Trailing zeros of the fraction are
+// omitted, so values calculated in code are encoded without representation errors (0.1+0.2 is encoded as 0.3).
+func (e *FieldEncoder) Float64(v float64, decimals int) {
+	if o, ok := e.originalField(0); ok {
+		if f, err := strconv.ParseFloat(o, 64); (err == nil && f == v) || (o == "" && v == 0) {
+			e.fields = append(e.fields, o)
+			return
+		}
+	}
+	e.fields = append(e.fields, formatFloat(v, decimals))
+}
+
+// NullFloat64 appends the Float64 value rounded to given number of decimal places (see Float64). Invalid value is
+// encoded as empty field.
+func (e *FieldEncoder) NullFloat64(v Float64, decimals int) {
+	if !v.Valid {
+		e.fields = append(e.fields, "")
+		return
+	}
+	if o, ok := e.originalField(0); ok && o == "" {
+		// original empty field would be parsed as invalid value
+		e.fields = append(e.fields, formatFloat(v.Value, decimals))
+		return
+	}
+	e.Float64(v.Value, decimals)
+}
+
+// formatFloat formats value rounded to given number of decimal places without trailing zeros of the fraction
+func formatFloat(v float64, decimals int) string {
+	s := strconv.FormatFloat(v, 'f', decimals, 64)
+	if strings.IndexByte(s, '.') != -1 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// Time appends the Time value in hhmmss.ss format. Invalid time is encoded as empty field.
+func (e *FieldEncoder) Time(v Time, context string) {
+	if o, ok := e.originalField(0); ok {
+		if t, err := ParseTime(o); err == nil && t == v {
+			e.fields = append(e.fields, o)
+			return
+		}
+	}
+	if !v.Valid {
+		e.fields = append(e.fields, "")
+		return
+	}
+	if v.Hour < 0 || v.Hour > 23 || v.Minute < 0 || v.Minute > 59 || v.Second < 0 || v.Second > 60 ||
+		v.Millisecond < 0 || v.Millisecond > 999 {
+		e.setFieldErr(&FieldError{Index: len(e.fields), Context: context, Value: v.String()})
+		e.fields = append(e.fields, "")
+		return
+	}
+	if v.Millisecond%10 == 0 {
+		e.fields = append(e.fields, fmt.Sprintf("%02d%02d%02d.%02d", v.Hour, v.Minute, v.Second, v.Millisecond/10))
+		return
+	}
+	e.fields = append(e.fields, fmt.Sprintf("%02d%02d%02d.%03d", v.Hour, v.Minute, v.Second, v.Millisecond))
+}
+
+// Date appends the Date value in ddmmyy format. Invalid date is encoded as empty field.
+func (e *FieldEncoder) Date(v Date, context string) {
+	if o, ok := e.originalField(0); ok {
+		if d, err := ParseDate(o); err == nil && d == v {
+			e.fields = append(e.fields, o)
+			return
+		}
+	}
+	if !v.Valid {
+		e.fields = append(e.fields, "")
+		return
+	}
+	if v.DD < 1 || v.DD > 31 || v.MM < 1 || v.MM > 12 || v.YY < 0 {
+		e.setFieldErr(&FieldError{Index: len(e.fields), Context: context, Value: v.String()})
+		e.fields = append(e.fields, "")
+		return
+	}
+	e.fields = append(e.fields, fmt.Sprintf("%02d%02d%02d", v.DD, v.MM, v.YY%100))
+}
+
+// Latitude appends two fields: latitude in ddmm.mmmm format and N/S hemisphere indicator.
+func (e *FieldEncoder) Latitude(v float64, context string) {
+	if v < -90.0 || 90.0 < v {
+		e.setFieldErr(&FieldError{Index: len(e.fields), Context: context, Reason: "latitude is not in range (-90, 90)"})
+	}
+	e.latLong(v, 2, LatDir(v))
+}
+
+// Longitude appends two fields: longitude in dddmm.mmmm format and E/W hemisphere indicator.
+func (e *FieldEncoder) Longitude(v float64, context string) {
+	if v < -180.0 || 180.0 < v {
+		e.setFieldErr(&FieldError{Index: len(e.fields), Context: context, Reason: "longitude is not in range (-180, 180)"})
+	}
+	e.latLong(v, 3, LonDir(v))
+}
+
+func (e *FieldEncoder) latLong(v float64, degreeDigits int, dir string) {
+	value, okValue := e.originalField(0)
+	direction, okDir := e.originalField(1)
+	if okValue && okDir {
+		if value == "" && direction == "" && v == 0 {
+			e.fields = append(e.fields, value, direction)
+			return
+		}
+		if l, err := ParseLatLong(value + " " + direction); err == nil && l == v {
+			e.fields = append(e.fields, value, direction)
+			return
+		}
+	}
+	gps := FormatGPS(v)
+	// FormatGPS does not pad degrees but NMEA0183 uses fixed width degrees (ddmm.mmmm, dddmm.mmmm)
+	if pad := degreeDigits + 7 - len(gps); pad > 0 {
+		gps = strings.Repeat("0", pad) + gps
+	}
+	e.fields = append(e.fields, gps, dir)
+}
+
+// SixBitASCIIArmour appends two fields: payload bits encoded with the 6-bit ascii armour used for VDM and VDO
+// messages and the number of fill bits.
+func (e *FieldEncoder) SixBitASCIIArmour(payload []byte, context string) {
+	encoded, okEncoded := e.originalField(0)
+	fillBits, okFillBits := e.originalField(1)
+	if okEncoded && okFillBits {
+		fill, err := strconv.Atoi(fillBits)
+		if err == nil {
+			p := NewParser(BaseSentence{Fields: []string{encoded}})
+			if decoded := p.SixBitASCIIArmour(0, fill, context); p.Err() == nil && bytes.Equal(decoded, payload) {
+				e.fields = append(e.fields, encoded, fillBits)
+				return
+			}
+		}
+	}
+
+	fill := (6 - len(payload)%6) % 6
+	result := make([]byte, 0, (len(payload)+fill)/6)
+	var (
+		d    byte
+		bits int
+	)
+	for _, b := range payload {
+		if b > 1 {
+			e.setFieldErr(&FieldError{Index: len(e.fields), Context: context, Reason: "data bit"})
+			e.fields = append(e.fields, "", "")
+			return
+		}
+		d = d<<1 | b
+		bits++
+		if bits == 6 {
+			result = append(result, armour(d))
+			d, bits = 0, 0
+		}
+	}
+	if bits > 0 {
+		result = append(result, armour(d<<uint(6-bits)))
+	}
+	e.fields = append(e.fields, string(result), strconv.Itoa(fill))
+}
+
+// armour encodes 6 bit value into ascii character
+func armour(d byte) byte {
+	d += 48
+	if d > 87 {
+		d += 8
+	}
+	return d
+}
@@ -0,0 +1,383 @@
+package nmea
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode_RoundTrip(t *testing.T) {
+	var tests = []struct {
+		name string
+		raw  string
+	}{
+		{name: "AAM", raw: "$GPAAM,A,A,0.10,N,WPTNME*32"},
+		{name: "ABM", raw: "!AIABM,26,2,1,3381581370,3,8,177KQJ5000G?tO`K>RA1wUbN0TKH,0*02"},
+		{name: "ACK", raw: "$VRACK,001*50"},
+		{name: "ACN", raw: "$RAACN,220516,TCK,002,1,A,C*00"},
+		{name: "ALA", raw: "$FRALA,143955,FR,OT,00,901,N,V,Syst Fault : AutroSafe comm. OK*4F"},
+		{name: "ALC", raw: "$FBALC,02,01,03,01,FEB,01,02,03*0A"},
+		{name: "ALF", raw: "$VDALF,1,0,1,220516,B,A,S,SAL,001,1,2,0,My alarm*2C"},
+		{name: "ALR", raw: "$RAALR,220516,001,A,A,*53"},
+		{name: "APB", raw: "$ECAPB,A,A,0.0,L,M,V,V,175.2,T,Antechamber_Bay,175.2,T,175.2,T,V*32"},
+		{name: "ARC", raw: "$RAARC,220516,TCK,002,1,A*73"},
+		{name: "BBM", raw: "!AIBBM,26,2,1,3,8,177KQJ5000G?tO`K>RA1wUbN0TKH,0*2C"},
+		{name: "BEC", raw: "$GPBEC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM*33"},
+		{name: "BOD", raw: "$GPBOD,097.0,T,103.2,M,POINTB,POINTA*4A"},
+		{name: "BWC", raw: "$GPBWC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,D*49"},
+		{name: "BWR", raw: "$GPBWR,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,D*58"},
+		{name: "BWW", raw: "$GPBWW,097.0,T,103.2,M,POINTB,POINTA*41"},
+		{name: "CDIN", raw: "$PCDIN,01F112,000C72EA,09,28C36A0000B40AFD*56"},
+		{name: "DBK", raw: "$SDDBK,12.3,f,3.7,M,2.0,F*2F"},
+		{name: "DBS", raw: "$23DBS,01.9,f,0.58,M,00.3,F*21"},
+		{name: "DBT", raw: "$IIDBT,032.93,f,010.04,M,005.42,F*2C"},
+		{name: "DOR", raw: "$FRDOR,E,233042,FD,FP,000,010,C,C,Door Closed : TEST FPA Name*4D"},
+		{name: "DPT", raw: "$SDDPT,0.5,0.5,0.1*54"},
+		{name: "DSC", raw: "$CDDSC,12,3381581370,12,06,00,1423108312,0236,3381581370, , S,    *20"},
+		{name: "DSE", raw: "$CDDSE,1,1,A,3380400790,00,46504437,01,16501437*17"},
+		{name: "DTM", raw: "$GPDTM,W84,X,00.1200,S,12.0000,W,100,W84*27"},
+		{name: "EVE", raw: "$FREVE,000001,DZ00513,Fire Alarm On: TEST DZ201 Name*0A"},
+		{name: "FIR", raw: "$FRFIR,E,103000,FD,PT,000,007,A,V,Fire Alarm : TEST PT7 Name TEST DZ2 Name*7A"},
+		{name: "GGA", raw: "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51"},
+		{name: "GLL", raw: "$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58"},
+		{name: "GNS", raw: "$GPGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138,S*6A"},
+		{name: "GRME", raw: "$PGRME,3.3,M,4.9,M,6.0,M*25"},
+		{name: "GRMT", raw: "$PGRMT,GOOD GPS VER 1.0,P,P,R,R,P,C,32,R*39"},
+		{name: "GSA", raw: "$GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83,4*0B"},
+		{name: "GSV", raw: "$GLGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*6B"},
+		{name: "HBT", raw: "$HCHBT,1.5,A,1*23"},
+		{name: "HDG", raw: "$HCHDG,98.3,0.1,E,12.6,W*56"},
+		{name: "HDM", raw: "$HCHDM,093.8,M*2B"},
+		{name: "HDT", raw: "$GPHDT,123.456,T*32"},
+		{name: "HSC", raw: "$FTHSC,40.12,T,39.11,M*5E"},
+		{name: "HTRO", raw: "$PHTRO,10.37,P,177.62,T*65"},
+		{name: "KLDS", raw: "$PKLDS,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,E00,100,2000,15,00,*72"},
+		{name: "KLID", raw: "$PKLID,00,100,2000,15,00,*6D"},
+		{name: "KLSH", raw: "$PKLSH,3926.7952,N,12000.5947,W,022732,A,100,2000*1A"},
+		{name: "KNDS", raw: "$PKNDS,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,E00,U00001,207,00,*3A"},
+		{name: "KNID", raw: "$PKNID,00,U00001,015,00,*24"},
+		{name: "KNSH", raw: "$PKNSH,3926.7952,N,12000.5947,W,022732,A,U00001*63"},
+		{name: "KWDWPL", raw: "$PKWDWPL,150803,A,4237.14,N,07120.83,W,173.8,231.8,190316,1120,test,/'*39"},
+		{name: "MDA", raw: "$WIMDA,3.02,I,1.01,B,23.4,C,,,40.2,,12.1,C,19.3,T,20.1,M,13.1,N,1.1,M*62"},
+		{name: "MTA", raw: "$IIMTA,13.3,C*04"},
+		{name: "MTK001", raw: "$PMTK001,604,3*32"},
+		{name: "MTW", raw: "$INMTW,17.9,C*1B"},
+		{name: "MWD", raw: "$WIMWD,10.1,T,10.1,M,12,N,40,M*5D"},
+		{name: "MWV", raw: "$WIMWV,12.1,T,10.1,N,A*27"},
+		{name: "OSD", raw: "$RAOSD,179.0,A,179.0,M,00.0,M,,,N*76"},
+		{name: "PGN", raw: "$MXPGN,01F112,2807,FC7FFF7FFF168012*11"},
+		{name: "Query", raw: "$CCGPQ,GGA*2B"},
+		{name: "RDID", raw: "$PRDID,-10.37,2.34,230.34*62"},
+		{name: "RMB", raw: "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V,D*48"},
+		{name: "RMC", raw: "$GNRMC,102014.00,A,5550.6082,N,03732.2488,E,000.00000,092.9,300518,,,A,V*3B"},
+		{name: "ROT", raw: "$HEROT,-11.23,A*07"},
+		{name: "RPM", raw: "$RCRPM,S,0,74.6,30.0,A*56"},
+		{name: "RSA", raw: "$IIRSA,10.5,A,0.4,A*70"},
+		{name: "RSD", raw: "$RARSD,0.00,,2.50,005.0,0.00,,4.50,355.0,,,3.0,N,H*51"},
+		{name: "RTE", raw: "$IIRTE,4,1,c,Rte 1,411,412,413,414,415*6F"},
+		{name: "SKPDPT", raw: "$PSKPDPT,0002.5,-01.1,0010,10,03,AFT*22"},
+		{name: "SONCMS", raw: "$PSONCMS,0.0905,0.4217,0.9020,-0.0196,-1.7685,0.3861,-9.6648,-0.0116,0.0065,-0.0080,0.0581,0.3846,0.7421,33.1*76"},
+		{name: "THS", raw: "$INTHS,123.456,A*20"},
+		{name: "TLB", raw: "$RATLB,1,XXX,2.0,YYY*55"},
+		{name: "TLL", raw: "$RATLL,1,3646.54266,N,00235.37778,W,test,020915,L,R*78"},
+		{name: "TTD", raw: "!RATTD,1A,01,1,177KQJ5000G?tO`K>RA1wUbN0TKH,0*72"},
+		{name: "TTM", raw: "$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,N,,T,,,M*2A"},
+		{name: "TXT", raw: "$GNTXT,01,01,02,u-blox AG - www.u-blox.com*4E"},
+		{name: "VBW", raw: "$VMVBW,-7.1,0.1,A,,,V,,V,,V*65"},
+		{name: "VDM", raw: "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55"},
+		{name: "VDR", raw: "$IIVDR,10.1,T,12.3,M,1.2,N*3A"},
+		{name: "VHW", raw: "$VWVHW,45.0,T,43.0,M,3.5,N,6.4,K*56"},
+		{name: "VLW", raw: "$IIVLW,10.1,N,3.2,N,1,N,0.1,N*62"},
+		{name: "VPW", raw: "$IIVPW,4.5,N,6.7,M*52"},
+		{name: "VSD", raw: "$RAVSD,0,4.5,6,@@@@@@@@@@@@@@@@@@@@,220516,01,02,8,*6E"},
+		{name: "VTG", raw: "$GPVTG,220.86,T,,M,2.550,N,4.724,K,A*34"},
+		{name: "VWR", raw: "$IIVWR,75,R,1.0,N,0.51,M,1.85,K*6C"},
+		{name: "VWT", raw: "$IIVWT,75,R,1.0,N,0.51,M,1.85,K*6A"},
+		{name: "WPL", raw: "$IIWPL,3356.4650,S,15124.5567,E,411*73"},
+		{name: "XDR", raw: "$HCXDR,A,171,D,PITCH,A,-37,D,ROLL,G,367,,MAGX,G,2420,,MAGY,G,-8984,,MAGZ*41"},
+		{name: "XTE", raw: "$GPXTE,V,V,10.1,L,N*6E"},
+		{name: "ZDA", raw: "$GPZDA,172809.456,12,07,1996,00,00*57"},
+		{name: "VDO", raw: "!AIVDO,1,1,,1,000,2*66"},
+		{name: "GSA without system ID", raw: "$GPGSA,A,3,22,19,18,27,14,03,,,,,,,3.1,2.0,2.4*36"},
+		{name: "TXT with escaped characters", raw: "$GPTXT,01,01,02,Hello^2C world ^5E*6C"},
+		{name: "RMC with negative variation", raw: "$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.raw)
+			assert.NoError(t, err)
+
+			raw, err := Encode(s)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.raw, raw)
+
+			// without original fields every value is formatted by the encoder
+			formatted := formattedOnly(s)
+			raw, err = Encode(formatted)
+			assert.NoError(t, err)
+			reparsed, err := Parse(raw)
+			if assert.NoError(t, err, raw) {
+				assertSentenceValues(t, reflect.ValueOf(formatted), reflect.ValueOf(formattedOnly(reparsed)), tt.name)
+			}
+		})
+	}
+}
+
+// formattedOnly returns copy of the sentence with original fields, checksum and raw sentence removed
+func formattedOnly(s Sentence) Sentence {
+	v := reflect.New(reflect.TypeOf(s)).Elem()
+	v.Set(reflect.ValueOf(s))
+	if b := v.FieldByName("BaseSentence"); b.IsValid() {
+		b.FieldByName("Fields").Set(reflect.Zero(b.FieldByName("Fields").Type()))
+		b.FieldByName("Raw").SetString("")
+		b.FieldByName("Checksum").SetString("")
+	}
+	return v.Interface().(Sentence)
+}
+
+// assertSentenceValues compares exported values of sentences, floats are compared with precision of encoded
+// latitude and longitude (0.0001 minutes)
+func assertSentenceValues(t *testing.T, expected, actual reflect.Value, path string) {
+	switch expected.Kind() {
+	case reflect.Float32, reflect.Float64:
+		assert.InDelta(t, expected.Float(), actual.Float(), 0.0001/60, path)
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			if f := expected.Type().Field(i); f.PkgPath == "" {
+				assertSentenceValues(t, expected.Field(i), actual.Field(i), path+"."+f.Name)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if !assert.Equal(t, expected.Len(), actual.Len(), path) {
+			return
+		}
+		for i := 0; i < expected.Len(); i++ {
+			assertSentenceValues(t, expected.Index(i), actual.Index(i), path)
+		}
+	default:
+		assert.Equal(t, expected.Interface(), actual.Interface(), path)
+	}
+}
+
+func TestEncode_ModifiedSentence(t *testing.T) {
+	s, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+
+	gga := s.(GGA)
+	gga.Time = Time{Valid: true, Hour: 3, Minute: 42, Second: 26, Millisecond: 100}
+	gga.Latitude = 33.9411
+	gga.NumSatellites = 12
+
+	raw, err := Encode(gga)
+	assert.NoError(t, err)
+	assert.Equal(t, "$GPGGA,034226.10,3356.4660,N,15124.5567,E,1,12,9.7,-25.0,M,21.0,M,,0000*7D", raw)
+}
+
+func TestEncode_NewSentence(t *testing.T) {
+	var tests = []struct {
+		name     string
+		sentence Sentence
+		raw      string
+		err      string
+	}{
+		{
+			name: "HDT",
+			sentence: HDT{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeHDT},
+				Heading:      123.4,
+				True:         true,
+			},
+			raw: "$GPHDT,123.4,T*31",
+		},
+		{
+			name: "HDT calculated heading",
+			sentence: HDT{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeHDT},
+				Heading:      0.1 + 0.2,
+				True:         true,
+			},
+			raw: "$GPHDT,0.3,T*36",
+		},
+		{
+			name: "DBT depth rounded to field precision",
+			sentence: DBT{
+				BaseSentence: BaseSentence{Talker: "SD", Type: TypeDBT},
+				DepthMeters:  10.0 / 3,
+			},
+			raw: "$SDDBT,0,f,3.333,M,0,F*06",
+		},
+		{
+			name: "RMC",
+			sentence: RMC{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeRMC},
+				Time:         Time{Valid: true, Hour: 22, Minute: 5, Second: 16},
+				Validity:     ValidRMC,
+				Latitude:     51.5636,
+				Longitude:    -0.704,
+				Speed:        173.8,
+				Course:       231.8,
+				Date:         Date{Valid: true, DD: 13, MM: 6, YY: 94},
+				Variation:    -4.2,
+			},
+			raw: "$GPRMC,220516.00,A,5133.8160,N,00042.2400,W,173.8,231.8,130694,4.2,W*5B",
+		},
+		{
+			name: "GLL minutes rounded up",
+			sentence: GLL{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeGLL},
+				Latitude:     10.99999999,
+				Longitude:    -(9 + 9.99996/60),
+			},
+			raw: "$GPGLL,1100.0000,N,00910.0000,W,,*71",
+		},
+		{
+			name: "VDM",
+			sentence: VDMVDO{
+				BaseSentence:   BaseSentence{Talker: "AI", Type: TypeVDM},
+				NumFragments:   1,
+				FragmentNumber: 1,
+				Channel:        "A",
+				Payload:        []byte{0, 0, 0, 0, 0, 1, 1, 1, 0, 0, 0, 0},
+			},
+			raw: "!AIVDM,1,1,,A,1h,0*7F",
+		},
+		{
+			name: "Query",
+			sentence: Query{
+				BaseSentence:        BaseSentence{Talker: "CC", Type: TypeQuery},
+				DestinationTalkerID: "GP",
+				RequestedSentence:   TypeGGA,
+			},
+			raw: "$CCGPQ,GGA*2B",
+		},
+		{
+			name: "invalid latitude",
+			sentence: GLL{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeGLL},
+				Latitude:     91,
+			},
+			err: "nmea: GPGLL invalid latitude: latitude is not in range (-90, 90)",
+		},
+		{
+			name: "invalid time",
+			sentence: GLL{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeGLL},
+				Time:         Time{Valid: true, Hour: 24},
+			},
+			err: "nmea: GPGLL invalid time: 24:00:00.0000",
+		},
+		{
+			name: "invalid 6-bit payload",
+			sentence: VDMVDO{
+				BaseSentence: BaseSentence{Talker: "AI", Type: TypeVDM},
+				Payload:      []byte{2},
+			},
+			err: "nmea: AIVDM invalid payload: data bit",
+		},
+		{
+			name:     "not encoder",
+			sentence: notEncoder{BaseSentence: BaseSentence{Talker: "AA", Type: "XXX"}},
+			err:      "nmea: sentence AAXXX does not implement Encoder",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := Encode(tt.sentence)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.raw, raw)
+
+			s, err := Parse(raw)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.sentence.DataType(), s.DataType())
+		})
+	}
+}
+
+type notEncoder struct {
+	BaseSentence
+}
+
+func (notEncoder) EncodeFields() {}
+
+func TestEncodeWithTagBlock(t *testing.T) {
+	var tests = []struct {
+		name string
+		raw  string
+	}{
+		{
+			name: "with tag block",
+			raw:  "\\s:Satelite_1,c:1553390539*62\\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl`K6`nV00Sv,0*52",
+		},
+		{
+			name: "without tag block",
+			raw:  "$HEROT,-11.23,A*07",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.raw)
+			assert.NoError(t, err)
+
+			raw, err := EncodeWithTagBlock(s)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.raw, raw)
+		})
+	}
+}
+
+func TestFormatTagBlock(t *testing.T) {
+	var tests = []struct {
+		name     string
+		tagBlock TagBlock
+		expected string
+	}{
+		{
+			name:     "empty",
+			tagBlock: TagBlock{},
+			expected: "",
+		},
+		{
+			name: "all fields",
+			tagBlock: TagBlock{
+				Time:         1553390539,
+				RelativeTime: 1553390540,
+				Destination:  "ais",
+				Grouping:     "1-2-3",
+				LineCount:    13,
+				Source:       "Satelite_1",
+				Text:         "hello",
+			},
+			expected: "\\g:1-2-3,n:13,s:Satelite_1,d:ais,c:1553390539,r:1553390540,t:hello*3D\\",
+		},
+		{
+			name: "extra parameters",
+			tagBlock: TagBlock{
+				Time:   1120959341,
+				Source: "r3669961",
+				extra:  "x:NorSat_1,i:vendor",
+			},
+			expected: "\\s:r3669961,c:1120959341,x:NorSat_1,i:vendor*15\\",
+		},
+		{
+			name:     "only extra parameters",
+			tagBlock: TagBlock{extra: "x:NorSat_1"},
+			expected: "\\x:NorSat_1*39\\",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := FormatTagBlock(tt.tagBlock)
+			assert.Equal(t, tt.expected, raw)
+			if raw != "" {
+				tagBlock, _, err := ParseTagBlock(raw)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.tagBlock, tagBlock)
+			}
+		})
+	}
+}
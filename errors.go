@@ -0,0 +1,134 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChecksumError is returned when sentence checksum is missing or does not match the calculated checksum.
+// Tag block checksum mismatch is reported as TagBlockError that wraps ChecksumError.
+type ChecksumError struct {
+	// Expected is the checksum calculated from the sentence
+	Expected string
+	// Actual is the checksum the sentence was received with. Empty when sentence does not contain checksum.
+	Actual string
+}
+
+// Error returns error message
+func (e *ChecksumError) Error() string {
+	if e.Actual == "" {
+		return "nmea: sentence does not contain checksum separator"
+	}
+	return fmt.Sprintf("nmea: sentence checksum mismatch [%s != %s]", e.Expected, e.Actual)
+}
+
+// FieldError is returned when sentence field value is missing or invalid
+type FieldError struct {
+	// Prefix is the sentence prefix (talker ID + sentence type, e.g. GPRMC)
+	Prefix string
+	// Index is the index of the field in sentence data fields (address field excluded). -1 when error is not
+	// related to a single field.
+	Index int
+	// Context is the description of the field (e.g. "latitude")
+	Context string
+	// Value is the raw field value
+	Value string
+	// Reason describes the problem when the raw value alone does not explain it (e.g. "index out of range")
+	Reason string
+	// Err is the underlying error if there is one
+	Err error
+}
+
+// Error returns error message
+func (e *FieldError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("nmea: %s %s: %v", e.Prefix, e.Reason, e.Err)
+	}
+	if e.Reason != "" {
+		return fmt.Sprintf("nmea: %s invalid %s: %s", e.Prefix, e.Context, e.Reason)
+	}
+	return fmt.Sprintf("nmea: %s invalid %s: %s", e.Prefix, e.Context, e.Value)
+}
+
+// Unwrap returns the underlying error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors contains errors of all invalid fields of the sentence. It is returned by parsers of built-in sentences
+// and by SentenceParser in lenient mode.
+type FieldErrors []*FieldError
+
+// Error returns error message
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the first field error
+func (e FieldErrors) Unwrap() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e[0]
+}
+
+// Invalid reports if the field at given index is invalid
+func (e FieldErrors) Invalid(index int) bool {
+	for _, err := range e {
+		if err.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// Indices returns indices of invalid fields
+func (e FieldErrors) Indices() []int {
+	indices := make([]int, 0, len(e))
+	for _, err := range e {
+		if err.Index != -1 {
+			indices = append(indices, err.Index)
+		}
+	}
+	return indices
+}
+
+// TagBlockError is returned when tag block is malformed or has invalid checksum
+type TagBlockError struct {
+	// Raw is the raw tag block content (without `\` delimiters) when it could be extracted
+	Raw string
+	// Message describes the problem
+	Message string
+	// Err is the underlying error (e.g. *ChecksumError) if there is one
+	Err error
+}
+
+// Error returns error message
+func (e *TagBlockError) Error() string {
+	return "nmea: " + e.Message
+}
+
+// Unwrap returns the underlying error
+func (e *TagBlockError) Unwrap() error {
+	return e.Err
+}
+
+// PrefixError is returned when sentence prefix (address field) is invalid
+type PrefixError struct {
+	// Prefix is the raw prefix
+	Prefix string
+	// Reason describes the problem
+	Reason string
+}
+
+// Error returns error message
+func (e *PrefixError) Error() string {
+	if e.Prefix == "" {
+		return "nmea: sentence prefix " + e.Reason
+	}
+	return fmt.Sprintf("nmea: sentence prefix '%s' %s", e.Prefix, e.Reason)
+}
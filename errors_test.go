@@ -0,0 +1,133 @@
+package nmea
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors_As(t *testing.T) {
+	var testCases = []struct {
+		name        string
+		whenInput   string
+		expect      error
+		expectedMsg string
+	}{
+		{
+			name:        "checksum mismatch",
+			whenInput:   "$HEROT,-11.23,A*FF",
+			expect:      &ChecksumError{Expected: "07", Actual: "FF"},
+			expectedMsg: "nmea: sentence checksum mismatch [07 != FF]",
+		},
+		{
+			name:        "missing checksum",
+			whenInput:   "$HEROT,-11.23,A",
+			expect:      &ChecksumError{Expected: "07"},
+			expectedMsg: "nmea: sentence does not contain checksum separator",
+		},
+		{
+			name:      "invalid field value",
+			whenInput: "$HEROT,-11.23,X*1E",
+			expect: &FieldError{
+				Prefix:  "HEROT",
+				Index:   1,
+				Context: "status valid",
+				Value:   "X",
+			},
+			expectedMsg: "nmea: HEROT invalid status valid: X",
+		},
+		{
+			name:      "missing field",
+			whenInput: "$HEROT,-11.23*6A",
+			expect: &FieldError{
+				Prefix:  "HEROT",
+				Index:   1,
+				Context: "status valid",
+				Reason:  "index out of range",
+			},
+			expectedMsg: "nmea: HEROT invalid status valid: index out of range",
+		},
+		{
+			name:      "invalid latitude",
+			whenInput: "$GPGLL,9926.7952,N,12000.5947,W,022732,A,A*52",
+			expect: &FieldError{
+				Prefix:  "GPGLL",
+				Index:   0,
+				Context: "latitude",
+				Value:   "9926.7952 N",
+				Reason:  "latitude is not in range (-90, 90)",
+			},
+			expectedMsg: "nmea: GPGLL invalid latitude: latitude is not in range (-90, 90)",
+		},
+		{
+			name:        "tag block error",
+			whenInput:   "\\s:Satelite_1,c:1553390539\\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl`K6`nV00Sv,0*52",
+			expect:      &TagBlockError{Raw: "s:Satelite_1,c:1553390539", Message: "tagblock does not contain checksum separator"},
+			expectedMsg: "nmea: tagblock does not contain checksum separator",
+		},
+		{
+			name:        "empty prefix",
+			whenInput:   "$,1,2*03",
+			expect:      &PrefixError{Reason: "is empty"},
+			expectedMsg: "nmea: sentence prefix is empty",
+		},
+		{
+			name:        "not supported",
+			whenInput:   "$GPXXX,1,2*4C",
+			expect:      &NotSupportedError{Prefix: "GPXXX"},
+			expectedMsg: "nmea: sentence prefix 'GPXXX' not supported",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.whenInput)
+
+			assert.EqualError(t, err, tc.expectedMsg)
+			switch expect := tc.expect.(type) {
+			case *ChecksumError:
+				var target *ChecksumError
+				assert.True(t, errors.As(err, &target))
+				assert.Equal(t, expect, target)
+			case *FieldError:
+				var target *FieldError
+				assert.True(t, errors.As(err, &target))
+				assert.Equal(t, expect, target)
+			case *TagBlockError:
+				var target *TagBlockError
+				assert.True(t, errors.As(err, &target))
+				assert.Equal(t, expect, target)
+			case *PrefixError:
+				var target *PrefixError
+				assert.True(t, errors.As(err, &target))
+				assert.Equal(t, expect, target)
+			case *NotSupportedError:
+				var target *NotSupportedError
+				assert.True(t, errors.As(err, &target))
+				assert.Equal(t, expect, target)
+			}
+		})
+	}
+}
+
+func TestTagBlockError_Checksum(t *testing.T) {
+	_, err := Parse("\\s:Satelite_1,c:1553390539*FF\\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl`K6`nV00Sv,0*52")
+	assert.EqualError(t, err, "nmea: tagblock checksum mismatch [62 != FF]")
+
+	var tagBlockErr *TagBlockError
+	assert.True(t, errors.As(err, &tagBlockErr))
+	var checksumErr *ChecksumError
+	assert.True(t, errors.As(err, &checksumErr))
+	assert.Equal(t, &ChecksumError{Expected: "62", Actual: "FF"}, checksumErr)
+}
+
+func TestFieldError_Unwrap(t *testing.T) {
+	_, err := Parse("$PCDIN,x1F112,000C72EA,09,28C36A0000B40AFD*1E")
+	assert.EqualError(t, err, "nmea: PCDIN failed to parse PGN field: strconv.ParseUint: parsing \"x1F112\": invalid syntax")
+
+	var fieldErr *FieldError
+	assert.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, 0, fieldErr.Index)
+	assert.Equal(t, "x1F112", fieldErr.Value)
+	assert.NotNil(t, errors.Unwrap(err))
+}
@@ -0,0 +1,105 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// EscapeChar is the token (caret `^`) that starts hex escape sequence `^hh` of reserved or non-printable
+	// character in sentence fields. For example `^2C` is comma and `^5E` is caret itself.
+	EscapeChar = '^'
+)
+
+// reservedChars are characters that have special meaning in NMEA0183 and must be escaped in field values
+const reservedChars = "\r\n$*,!\\^~\x7f"
+
+// UnescapeField decodes `^hh` hex escape sequences in field value. Escape character must be followed by two
+// hexadecimal digits.
+func UnescapeField(field string) (string, error) {
+	result, invalidAt := unescapeField(field)
+	if invalidAt != -1 {
+		return "", fmt.Errorf("nmea: invalid escape sequence %q", escapeSequenceAt(field, invalidAt))
+	}
+	return result, nil
+}
+
+// unescapeField decodes hex escape sequences in field value. Returns position of the first invalid escape
+// sequence or -1 when all sequences were valid.
+func unescapeField(field string) (string, int) {
+	if strings.IndexByte(field, EscapeChar) == -1 {
+		return field, -1
+	}
+	var sb strings.Builder
+	sb.Grow(len(field))
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		if c != EscapeChar {
+			sb.WriteByte(c)
+			continue
+		}
+		if i+2 >= len(field) {
+			return "", i
+		}
+		hi, okHi := fromHexChar(field[i+1])
+		lo, okLo := fromHexChar(field[i+2])
+		if !okHi || !okLo {
+			return "", i
+		}
+		sb.WriteByte(hi<<4 | lo)
+		i += 2
+	}
+	return sb.String(), -1
+}
+
+// escapeSequenceAt returns (possibly truncated) escape sequence starting at position i
+func escapeSequenceAt(field string, i int) string {
+	if i+3 > len(field) {
+		return field[i:]
+	}
+	return field[i : i+3]
+}
+
+// EscapeField encodes reserved (`<CR>`, `<LF>`, `$`, `*`, `,`, `!`, `\`, `^`, `~`, `<DEL>`) and non-printable
+// characters in field value with `^hh` hex escape sequences.
+func EscapeField(field string) string {
+	if !needsEscaping(field) {
+		return field
+	}
+	var sb strings.Builder
+	sb.Grow(len(field) + 6)
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		if isEscaped(c) {
+			fmt.Fprintf(&sb, "%c%02X", EscapeChar, c)
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+func needsEscaping(field string) bool {
+	for i := 0; i < len(field); i++ {
+		if isEscaped(field[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEscaped(c byte) bool {
+	return c < 0x20 || c > 0x7e || strings.IndexByte(reservedChars, c) != -1
+}
+
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
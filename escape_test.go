@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnescapeField(t *testing.T) {
+	var tests = []struct {
+		name     string
+		field    string
+		expected string
+		err      string
+	}{
+		{name: "no escapes", field: "hello world", expected: "hello world"},
+		{name: "empty", field: "", expected: ""},
+		{name: "comma", field: "a^2Cb", expected: "a,b"},
+		{name: "lower case hex", field: "a^2cb", expected: "a,b"},
+		{name: "caret", field: "^5E", expected: "^"},
+		{name: "multiple", field: "^24^2A^21^5C^7E", expected: "$*!\\~"},
+		{name: "ISO 8859-1 character", field: "50^B0C", expected: "50\xb0C"},
+		{name: "truncated", field: "abc^2", err: `nmea: invalid escape sequence "^2"`},
+		{name: "caret at the end", field: "abc^", err: `nmea: invalid escape sequence "^"`},
+		{name: "not hex", field: "^ZZabc", err: `nmea: invalid escape sequence "^ZZ"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := UnescapeField(tt.field)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEscapeField(t *testing.T) {
+	var tests = []struct {
+		name     string
+		field    string
+		expected string
+	}{
+		{name: "no reserved characters", field: "hello world", expected: "hello world"},
+		{name: "empty", field: "", expected: ""},
+		{name: "comma", field: "a,b", expected: "a^2Cb"},
+		{name: "caret", field: "^", expected: "^5E"},
+		{name: "reserved characters", field: "$*!\\~\r\n\x7f", expected: "^24^2A^21^5C^7E^0D^0A^7F"},
+		{name: "ISO 8859-1 character", field: "50\xb0C", expected: "50^B0C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := EscapeField(tt.field)
+			assert.Equal(t, tt.expected, result)
+
+			unescaped, err := UnescapeField(result)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.field, unescaped)
+		})
+	}
+}
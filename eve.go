@@ -21,7 +21,7 @@ type EVE struct {
 
 // newEVE constructor
 func newEVE(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeEVE)
 	return EVE{
 		BaseSentence: s,
@@ -30,3 +30,12 @@ func newEVE(s BaseSentence) (Sentence, error) {
 		Message:      p.String(2, "event message text"),
 	}, p.Err()
 }
+
+// EncodeFields returns the EVE sentence fields in wire format
+func (s EVE) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.TagCode)
+	e.String(s.Message)
+	return e.Fields()
+}
@@ -85,7 +85,7 @@ type FIR struct {
 
 // newFIR constructor
 func newFIR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeFIR)
 	return FIR{
 		BaseSentence:              s,
@@ -100,3 +100,18 @@ func newFIR(s BaseSentence) (Sentence, error) {
 		Message:                   p.String(8, "message"),
 	}, p.Err()
 }
+
+// EncodeFields returns the FIR sentence fields in wire format
+func (s FIR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.Type)
+	e.Time(s.Time, "time")
+	e.String(s.SystemIndicator)
+	e.String(s.DivisionIndicator1)
+	e.Int64(s.DivisionIndicator2)
+	e.Int64(s.FireDetectorNumberOrCount)
+	e.String(s.Condition)
+	e.String(s.AlarmAckState)
+	e.String(s.Message)
+	return e.Fields()
+}
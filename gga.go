@@ -1,5 +1,7 @@
 package nmea
 
+import "time"
+
 const (
 	// TypeGGA type for GGA sentences
 	TypeGGA = "GGA"
@@ -41,9 +43,16 @@ type GGA struct {
 
 // newGGA constructor
 func newGGA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	m := GGA{}
+	err := m.Decode(s)
+	return m, err
+}
+
+// Decode parses base sentence into GGA. Implements Decodable.
+func (m *GGA) Decode(s BaseSentence) error {
+	p := newParser(s)
 	p.AssertType(TypeGGA)
-	return GGA{
+	*m = GGA{
 		BaseSentence:  s,
 		Time:          p.Time(0, "time"),
 		Latitude:      p.LatLong(1, 2, "latitude"),
@@ -55,5 +64,35 @@ func newGGA(s BaseSentence) (Sentence, error) {
 		Separation:    p.Float64(10, "separation"),
 		DGPSAge:       p.String(12, "dgps age"),
 		DGPSId:        p.String(13, "dgps id"),
-	}, p.Err()
+	}
+	return p.Err()
+}
+
+// Timestamp returns UTC time of the sentence. Sentence contains only time of day, date is taken from the reference
+// time, see TimeOfDay.
+func (s GGA) Timestamp(ref time.Time) (time.Time, error) {
+	return TimeOfDay(ref, s.Time)
+}
+
+// Position returns position of the fix
+func (s GGA) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the GGA sentence fields in wire format
+func (s GGA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.String(s.FixQuality)
+	e.Int64(s.NumSatellites)
+	e.Float64(s.HDOP, 2)
+	e.Float64(s.Altitude, 3)
+	e.Fixed(DistanceUnitMetre)
+	e.Float64(s.Separation, 3)
+	e.Fixed(DistanceUnitMetre)
+	e.String(s.DGPSAge)
+	e.String(s.DGPSId)
+	return e.Fields()
 }
@@ -1,5 +1,7 @@
 package nmea
 
+import "time"
+
 const (
 	// TypeGLL type for GLL sentences
 	TypeGLL = "GLL"
@@ -28,7 +30,7 @@ type GLL struct {
 
 // newGLL constructor
 func newGLL(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeGLL)
 	gll := GLL{
 		BaseSentence: s,
@@ -42,3 +44,27 @@ func newGLL(s BaseSentence) (Sentence, error) {
 	}
 	return gll, p.Err()
 }
+
+// Timestamp returns UTC time of the sentence. Sentence contains only time of day, date is taken from the reference
+// time, see TimeOfDay.
+func (s GLL) Timestamp(ref time.Time) (time.Time, error) {
+	return TimeOfDay(ref, s.Time)
+}
+
+// Position returns position of the fix
+func (s GLL) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the GLL sentence fields in wire format
+func (s GLL) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	if len(s.Fields) > 6 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
@@ -1,5 +1,10 @@
 package nmea
 
+import (
+	"strings"
+	"time"
+)
+
 const (
 	// TypeGNS type for GNS sentences
 	TypeGNS = "GNS"
@@ -55,12 +60,12 @@ type GNS struct {
 	Separation float64 // Geoidal separation meters
 	Age        float64 // Age of differential data
 	Station    int64   // Differential reference station ID
-	NavStatus  string  // Navigation status (NMEA 4.1+). See NavStats* (`NavStatusAutonomous` etc) constants for possible values.
+	NavStatus  string  // Navigation status (NMEA 4.10+). See NavStats* (`NavStatusAutonomous` etc) constants for possible values.
 }
 
 // newGNS Constructor
 func newGNS(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeGNS)
 	m := GNS{
 		BaseSentence: s,
@@ -75,7 +80,7 @@ func newGNS(s BaseSentence) (Sentence, error) {
 		Age:          p.Float64(10, "age"),
 		Station:      p.Int64(11, "station"),
 	}
-	if len(p.Fields) >= 13 {
+	if p.versionedField(12, "navigation status", m.Layout(), Version410) {
 		m.NavStatus = p.EnumString(
 			12,
 			"navigation status",
@@ -87,3 +92,46 @@ func newGNS(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// Layout returns NMEA 0183 version of the sentence layout: configured version (BaseSentence.Version) or version
+// detected from the number of fields.
+func (s GNS) Layout() Version {
+	if len(s.Fields) >= 13 {
+		return s.versionOr(Version410)
+	}
+	return s.versionOr(Version30)
+}
+
+// Timestamp returns UTC time of the sentence. Sentence contains only time of day, date is taken from the reference
+// time, see TimeOfDay.
+func (s GNS) Timestamp(ref time.Time) (time.Time, error) {
+	return TimeOfDay(ref, s.Time)
+}
+
+// Position returns position of the fix
+func (s GNS) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the GNS sentence fields in wire format
+func (s GNS) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.String(strings.Join(s.Mode, ""))
+	e.Int64(s.SVs)
+	e.Float64(s.HDOP, 2)
+	e.Float64(s.Altitude, 3)
+	e.Float64(s.Separation, 3)
+	e.Float64(s.Age, 1)
+	e.Int64(s.Station)
+	navStatus := len(s.Fields) >= 13 || s.NavStatus != ""
+	if v := s.configuredVersion(); v != VersionUnknown {
+		navStatus = v >= Version410
+	}
+	if navStatus {
+		e.String(s.NavStatus)
+	}
+	return e.Fields()
+}
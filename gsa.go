@@ -1,5 +1,7 @@
 package nmea
 
+import "strings"
+
 const (
 	// TypeGSA type for GSA sentences
 	TypeGSA = "GSA"
@@ -20,7 +22,8 @@ const (
 // https://gpsd.gitlab.io/gpsd/NMEA.html#_gsa_gps_dop_and_active_satellites
 //
 // Format:             $--GSA,a,a,x,x,x,x,x,x,x,x,x,x,x,x,x,x,x.x,x.x,x.x*hh<CR><LF>
-// Format (NMEA 4.1+): $--GSA,a,a,x,x,x,x,x,x,x,x,x,x,x,x,x,x,x.x,x.x,x.x,x*hh<CR><LF>
+// Format (NMEA 4.10+): $--GSA,a,a,x,x,x,x,x,x,x,x,x,x,x,x,x,x,x.x,x.x,x.x,x*hh<CR><LF>
+// Format (NMEA 4.11):  $--GSA,a,a,x,x,x,x,x,x,x,x,x,x,x,x,x,x,x.x,x.x,x.x,x,h*hh<CR><LF>
 // Example: $GNGSA,A,3,80,71,73,79,69,,,,,,,,1.83,1.09,1.47*17
 // Example (NMEA 4.1+): $GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83,4*0B
 type GSA struct {
@@ -31,7 +34,7 @@ type GSA struct {
 	PDOP    float64  // Dilution of precision.
 	HDOP    float64  // Horizontal dilution of precision.
 	VDOP    float64  // Vertical dilution of precision.
-	// SystemID is (GNSS) System ID (NMEA 4.1+)
+	// SystemID is (GNSS) System ID (NMEA 4.10+)
 	// 1 - GPS
 	// 2 - GLONASS
 	// 3 - Galileo
@@ -39,11 +42,14 @@ type GSA struct {
 	// 5 - QZSS
 	// 6 - NavID (IRNSS)
 	SystemID int64
+	// SignalID is GNSS signal ID of the satellites (NMEA 4.11, optional). Values depend on the system, for example
+	// GPS: 1 - L1 C/A, 5 - L2 CM, 6 - L2 CL. 0 means all signals.
+	SignalID int64
 }
 
 // newGSA parses the GSA sentence into this struct.
 func newGSA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeGSA)
 	m := GSA{
 		BaseSentence: s,
@@ -61,8 +67,68 @@ func newGSA(s BaseSentence) (Sentence, error) {
 	m.HDOP = p.Float64(15, "hdop")
 	m.VDOP = p.Float64(16, "vdop")
 
-	if len(p.Fields) > 17 {
+	layout := m.Layout()
+	if p.versionedField(17, "system ID", layout, Version410) {
 		m.SystemID = p.Int64(17, "system ID")
 	}
+	if p.versionedField(18, "signal ID", layout, Version411) {
+		m.SignalID = p.HexInt64(18, "signal ID")
+	}
 	return m, p.Err()
 }
+
+// Layout returns NMEA 0183 version of the sentence layout: configured version (BaseSentence.Version) or version
+// detected from the number of fields.
+func (s GSA) Layout() Version {
+	switch {
+	case len(s.Fields) > 18:
+		return s.versionOr(Version411)
+	case len(s.Fields) > 17:
+		return s.versionOr(Version410)
+	}
+	return s.versionOr(Version2)
+}
+
+// EncodeFields returns the GSA sentence fields in wire format
+func (s GSA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.Mode)
+	e.String(s.FixType)
+	// Satellites in view. Keep original layout of (empty) satellite fields when satellites have not changed.
+	slots := make([]string, 12)
+	copy(slots, s.SV)
+	if len(s.Fields) >= 14 {
+		var original []string
+		for _, v := range s.Fields[2:14] {
+			if v != "" {
+				original = append(original, v)
+			}
+		}
+		if strings.Join(original, FieldSep) == strings.Join(s.SV, FieldSep) {
+			copy(slots, s.Fields[2:14])
+		}
+	}
+	for _, sv := range slots {
+		e.String(sv)
+	}
+	if len(s.SV) > len(slots) {
+		e.SetErr("satellite in view", "more than 12 satellites")
+	}
+	// Dilution of precision.
+	e.Float64(s.PDOP, 2)
+	e.Float64(s.HDOP, 2)
+	e.Float64(s.VDOP, 2)
+	systemID := len(s.Fields) > 17 || s.SystemID != 0 || s.SignalID != 0
+	signalID := len(s.Fields) > 18 || s.SignalID != 0
+	if v := s.configuredVersion(); v != VersionUnknown {
+		systemID = v >= Version410
+		signalID = signalID && v >= Version411
+	}
+	if systemID {
+		e.Int64(s.SystemID)
+	}
+	if signalID {
+		e.HexInt64(s.SignalID, 1)
+	}
+	return e.Fields()
+}
@@ -10,22 +10,25 @@ const (
 // https://gpsd.gitlab.io/gpsd/NMEA.html#_gsv_satellites_in_view
 //
 // Format:              $--GSV,x,x,x,x,x,x,x,...*hh<CR><LF>
-// Format (NMEA 4.1+):  $--GSV,x,x,x,x,x,x,x,...,x*hh<CR><LF>
+// Format (NMEA 4.10+): $--GSV,x,x,x,x,x,x,x,...,h*hh<CR><LF>
 // Example: $GPGSV,3,1,11,09,76,148,32,05,55,242,29,17,33,054,30,14,27,314,24*71
-// Example (NMEA 4.1+): $GAGSV,3,1,09,02,00,179,,04,09,321,,07,11,134,11,11,10,227,,7*7F
+// Example (NMEA 4.10+): $GAGSV,3,1,09,02,00,179,,04,09,321,,07,11,134,11,11,10,227,,7*7F
 type GSV struct {
 	BaseSentence
 	TotalMessages   int64     // Total number of messages of this type in this cycle
 	MessageNumber   int64     // Message number
 	NumberSVsInView int64     // Total number of SVs in view
 	Info            []GSVInfo // visible satellite info (0-4 of these)
-	// SystemID is (GNSS) System ID (NMEA 4.1+)
-	// 1 - GPS
-	// 2 - GLONASS
-	// 3 - Galileo
-	// 4 - BeiDou
-	// 5 - QZSS
-	// 6 - NavID (IRNSS)
+	// SignalID is GNSS signal ID of the satellites (NMEA 4.10+). Values depend on the system (talker ID), for example
+	// GPS: 1 - L1 C/A, 5 - L2 CM, 6 - L2 CL; Galileo: 1 - E5a, 2 - E5b, 7 - E1. 0 means all signals.
+	//
+	// Signal ID is a hexadecimal digit. Earlier versions of this package parsed the field as decimal number, so
+	// signal IDs A-F were rejected and field with more digits (not valid per standard) now has different value
+	// ("10" is 16).
+	SignalID int64
+	// SystemID has the same value as SignalID. In GSV sentence the system is given by talker ID.
+	//
+	// Deprecated: use SignalID
 	SystemID int64
 }
 
@@ -39,7 +42,7 @@ type GSVInfo struct {
 
 // newGSV constructor
 func newGSV(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeGSV)
 	m := GSV{
 		BaseSentence:    s,
@@ -60,8 +63,47 @@ func newGSV(s BaseSentence) (Sentence, error) {
 		})
 	}
 	idxSID := (6 + (i-1)*4) + 1
-	if len(p.Fields) == idxSID+1 {
-		m.SystemID = p.Int64(idxSID, "system ID")
+	if len(p.Fields) == idxSID+1 && p.versionedField(idxSID, "signal ID", m.Layout(), Version410) {
+		m.SignalID = p.HexInt64(idxSID, "signal ID")
+		m.SystemID = m.SignalID
 	}
 	return m, p.Err()
 }
+
+// Layout returns NMEA 0183 version of the sentence layout: configured version (BaseSentence.Version) or version
+// detected from the number of fields.
+func (s GSV) Layout() Version {
+	if len(s.Fields) == 3+len(s.Info)*4+1 {
+		return s.versionOr(Version410)
+	}
+	return s.versionOr(Version2)
+}
+
+// EncodeFields returns the GSV sentence fields in wire format
+func (s GSV) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.TotalMessages)
+	e.Int64(s.MessageNumber)
+	e.Int64(s.NumberSVsInView)
+	for _, info := range s.Info {
+		e.ZeroPaddedInt64(info.SVPRNNumber, 2)
+		e.ZeroPaddedInt64(info.Elevation, 2)
+		e.ZeroPaddedInt64(info.Azimuth, 3)
+		e.ZeroPaddedInt64(info.SNR, 2)
+	}
+	if len(s.Info) > 4 {
+		e.SetErr("SV info", "more than 4 satellites")
+	}
+	signalID := s.SignalID
+	if signalID == 0 {
+		signalID = s.SystemID
+	}
+	hasSignalID := len(s.Fields) == 3+len(s.Info)*4+1 || signalID != 0
+	if v := s.configuredVersion(); v != VersionUnknown {
+		hasSignalID = v >= Version410
+	}
+	if hasSignalID {
+		e.HexInt64(signalID, 1)
+	}
+	return e.Fields()
+}
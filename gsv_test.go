@@ -64,9 +64,52 @@ var gsvtests = []struct {
 				{SVPRNNumber: 7, Elevation: 11, Azimuth: 134, SNR: 11},
 				{SVPRNNumber: 11, Elevation: 10, Azimuth: 227, SNR: 0},
 			},
+			SignalID: 7,
 			SystemID: 7,
 		},
 	},
+	{
+		name: "good sentence with hex signal id",
+		raw:  "$GAGSV,1,1,01,02,00,179,,B*3A",
+		msg: GSV{
+			TotalMessages:   1,
+			MessageNumber:   1,
+			NumberSVsInView: 1,
+			Info: []GSVInfo{
+				{SVPRNNumber: 2, Elevation: 0, Azimuth: 179, SNR: 0},
+			},
+			SignalID: 11,
+			SystemID: 11,
+		},
+	},
+	{
+		name: "signal id A is 10",
+		raw:  "$GAGSV,1,1,01,02,00,179,,A*39",
+		msg: GSV{
+			TotalMessages:   1,
+			MessageNumber:   1,
+			NumberSVsInView: 1,
+			Info: []GSVInfo{
+				{SVPRNNumber: 2, Elevation: 0, Azimuth: 179, SNR: 0},
+			},
+			SignalID: 10,
+			SystemID: 10,
+		},
+	},
+	{
+		name: "signal id is hexadecimal",
+		raw:  "$GAGSV,1,1,01,02,00,179,,10*79",
+		msg: GSV{
+			TotalMessages:   1,
+			MessageNumber:   1,
+			NumberSVsInView: 1,
+			Info: []GSVInfo{
+				{SVPRNNumber: 2, Elevation: 0, Azimuth: 179, SNR: 0},
+			},
+			SignalID: 16,
+			SystemID: 16,
+		},
+	},
 	{
 		name: "invalid number of svs",
 		raw:  "$GLGSV,3,1,11.2,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*77",
@@ -22,7 +22,7 @@ type HBT struct {
 
 // newHBT constructor
 func newHBT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeHBT)
 	m := HBT{
 		BaseSentence:    s,
@@ -32,3 +32,12 @@ func newHBT(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the HBT sentence fields in wire format
+func (s HBT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Interval, 1)
+	e.String(s.OperationStatus)
+	e.Int64(s.MessageID)
+	return e.Fields()
+}
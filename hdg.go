@@ -1,5 +1,7 @@
 package nmea
 
+import "math"
+
 const (
 	// TypeHDG type of HDG sentence for vessel heading, deviation and variation with respect to magnetic north.
 	TypeHDG = "HDG"
@@ -22,7 +24,7 @@ type HDG struct {
 
 // newHDG constructor
 func newHDG(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeHDG)
 	m := HDG{
 		BaseSentence:       s,
@@ -34,3 +36,42 @@ func newHDG(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// MagneticHeading returns magnetic heading, sensor heading corrected with deviation
+func (s HDG) MagneticHeading() float64 {
+	return normalizeBearing(s.Heading + signedAngle(s.Deviation, s.DeviationDirection))
+}
+
+// TrueHeading returns true heading, magnetic heading corrected with variation. When variation is empty magnetic
+// declination (degrees, positive east, see MagneticDeclination) is used instead.
+func (s HDG) TrueHeading(declination float64) float64 {
+	if s.VariationDirection != "" {
+		declination = signedAngle(s.Variation, s.VariationDirection)
+	}
+	return trueFromMagnetic(s.MagneticHeading(), declination)
+}
+
+// FillVariation returns copy of the sentence with empty variation set to magnetic declination (degrees, positive
+// east, see MagneticDeclination)
+func (s HDG) FillVariation(declination float64) HDG {
+	if s.VariationDirection != "" {
+		return s
+	}
+	s.Variation = math.Abs(declination)
+	s.VariationDirection = East
+	if declination < 0 {
+		s.VariationDirection = West
+	}
+	return s
+}
+
+// EncodeFields returns the HDG sentence fields in wire format
+func (s HDG) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Heading, 3)
+	e.Float64(s.Deviation, 3)
+	e.String(s.DeviationDirection)
+	e.Float64(s.Variation, 3)
+	e.String(s.VariationDirection)
+	return e.Fields()
+}
@@ -20,7 +20,7 @@ type HDM struct {
 
 // newHDM constructor
 func newHDM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeHDM)
 	m := HDM{
 		BaseSentence:  s,
@@ -29,3 +29,21 @@ func newHDM(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// ToHDT returns HDT sentence with true heading calculated from magnetic heading and magnetic declination (degrees,
+// positive east, see MagneticDeclination)
+func (s HDM) ToHDT(declination float64) HDT {
+	return HDT{
+		BaseSentence: BaseSentence{Talker: s.Talker, Type: TypeHDT, TagBlock: s.TagBlock},
+		Heading:      trueFromMagnetic(s.Heading, declination),
+		True:         true,
+	}
+}
+
+// EncodeFields returns the HDM sentence fields in wire format
+func (s HDM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Heading, 3)
+	e.Bool(s.MagneticValid, MagneticHDM, "")
+	return e.Fields()
+}
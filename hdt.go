@@ -19,7 +19,7 @@ type HDT struct {
 
 // newHDT constructor
 func newHDT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeHDT)
 	m := HDT{
 		BaseSentence: s,
@@ -28,3 +28,21 @@ func newHDT(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// ToHDM returns HDM sentence with magnetic heading calculated from true heading and magnetic declination (degrees,
+// positive east, see MagneticDeclination)
+func (s HDT) ToHDM(declination float64) HDM {
+	return HDM{
+		BaseSentence:  BaseSentence{Talker: s.Talker, Type: TypeHDM, TagBlock: s.TagBlock},
+		Heading:       magneticFromTrue(s.Heading, declination),
+		MagneticValid: true,
+	}
+}
+
+// EncodeFields returns the HDT sentence fields in wire format
+func (s HDT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Heading, 3)
+	e.Bool(s.True, HeadingTrue, "")
+	return e.Fields()
+}
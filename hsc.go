@@ -21,7 +21,7 @@ type HSC struct {
 
 // newHSC constructor
 func newHSC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeHSC)
 	return HSC{
 		BaseSentence:        s,
@@ -31,3 +31,13 @@ func newHSC(s BaseSentence) (Sentence, error) {
 		MagneticHeadingType: p.EnumString(3, "magnetic heading type", HeadingMagnetic),
 	}, p.Err()
 }
+
+// EncodeFields returns the HSC sentence fields in wire format
+func (s HSC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.TrueHeading, 3)
+	e.String(s.TrueHeadingType)
+	e.Float64(s.MagneticHeading, 3)
+	e.String(s.MagneticHeadingType)
+	return e.Fields()
+}
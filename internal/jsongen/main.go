@@ -0,0 +1,63 @@
+// Command jsongen generates MarshalJSON and UnmarshalJSON methods of built-in sentence types. Types are taken from
+// prototypes of the default metadata registry, types outside of the registry (e.g. deprecated MTK) are given as
+// arguments.
+//
+// Usage: go run ./internal/jsongen -o json_sentences.go [type ...]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/adrianmo/go-nmea"
+)
+
+func main() {
+	output := flag.String("o", "json_sentences.go", "output file")
+	flag.Parse()
+
+	seen := map[string]bool{}
+	for _, sentenceType := range nmea.DefaultMetadataRegistry().Types() {
+		info, _ := nmea.LookupSentenceInfo(sentenceType)
+		if info.Prototype != nil {
+			seen[reflect.TypeOf(info.Prototype).Name()] = true
+		}
+	}
+	for _, name := range flag.Args() {
+		seen[name] = true
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/jsongen; DO NOT EDIT.\n\npackage nmea\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, `
+// MarshalJSON encodes the %[1]s sentence as JSON (see MarshalSentence)
+func (s %[1]s) MarshalJSON() ([]byte, error) {
+	return MarshalSentence(s)
+}
+
+// UnmarshalJSON decodes the %[1]s sentence from JSON (see MarshalSentence)
+func (s *%[1]s) UnmarshalJSON(data []byte) error {
+	return unmarshalSentenceInto(data, s)
+}
+`, name)
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,493 @@
+package nmea
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+//go:generate go run ./internal/jsongen -o json_sentences.go MTK
+
+var (
+	jsonNull   = []byte("null")
+	jsonTimeRe = regexp.MustCompile(`^(\d{2}):(\d{2}):(\d{2})(?:\.(\d+))?$`)
+	jsonDateRe = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+)
+
+// sentenceJSON is the JSON envelope of sentence
+type sentenceJSON struct {
+	Type     string                     `json:"type"`
+	Talker   string                     `json:"talker"`
+	Checksum string                     `json:"checksum"`
+	Raw      string                     `json:"raw"`
+	TagBlock json.RawMessage            `json:"tag_block"`
+	Version  *string                    `json:"version"`
+	Data     map[string]json.RawMessage `json:"data"`
+}
+
+// MarshalSentence encodes sentence as JSON object with following members:
+//   - "type" is sentence type (DataType), used as discriminator when decoding with UnmarshalSentence
+//   - "talker" is talker ID
+//   - "checksum" is checksum of the received sentence
+//   - "raw" is the received sentence
+//   - "tag_block" is tag block object (members are TagBlock field names in snake_case) or null
+//   - "version" is NMEA 0183 version configured for the talker of the sentence (e.g. "4.10"), omitted when not set
+//   - "data" is object with sentence fields. Member names are field names in snake_case, values are encoded as in
+//     ToMap: Time, Date, Float64 and Int64 are ISO 8601 strings or numbers, null when not valid. Nested structs are
+//     objects and byte slices are base64 strings.
+//
+// Example: {"type":"HDT","talker":"GP","checksum":"32","raw":"$GPHDT,123.456,T*32","tag_block":null,
+// "data":{"heading":123.456,"true":true}}
+//
+// Member names and value encodings are stable, JSONSchema returns JSON schema of the sentence type.
+func MarshalSentence(s Sentence) ([]byte, error) {
+	m := ToMap(s)
+	if m == nil {
+		return jsonNull, nil
+	}
+	base, _ := m[BaseSentenceKey].(map[string]interface{})
+	delete(m, BaseSentenceKey)
+
+	envelope := struct {
+		Type     string                 `json:"type"`
+		Talker   string                 `json:"talker"`
+		Checksum interface{}            `json:"checksum"`
+		Raw      interface{}            `json:"raw"`
+		TagBlock interface{}            `json:"tag_block"`
+		Version  interface{}            `json:"version,omitempty"`
+		Data     map[string]interface{} `json:"data"`
+	}{
+		Type:     s.DataType(),
+		Talker:   s.TalkerID(),
+		Checksum: base["checksum"],
+		Raw:      base["raw"],
+		TagBlock: base["tag_block"],
+		Version:  base["version"],
+		Data:     m,
+	}
+	if envelope.Checksum == nil {
+		envelope.Checksum = ""
+	}
+	if envelope.Raw == nil {
+		envelope.Raw = ""
+	}
+	return json.Marshal(envelope)
+}
+
+// UnmarshalSentence decodes sentence encoded with MarshalSentence into sentence of concrete type. Sentence type is
+// determined by "type" member: built-in sentences and sentences registered with SentenceInfo.Prototype or
+// RegisterStructParser are supported. BaseSentence.Fields of the decoded sentence are not set.
+func UnmarshalSentence(data []byte) (Sentence, error) {
+	var envelope struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+	typ, ok := sentenceTypeOf(envelope.Type)
+	if !ok {
+		return nil, &NotSupportedError{Prefix: envelope.Type}
+	}
+	v := reflect.New(typ)
+	if err := unmarshalSentenceInto(data, v.Interface()); err != nil {
+		return nil, err
+	}
+	if s, ok := v.Elem().Interface().(Sentence); ok {
+		return s, nil
+	}
+	return v.Interface().(Sentence), nil
+}
+
+// sentenceTypeOf returns Go type of sentences of the sentence type
+func sentenceTypeOf(sentenceType string) (reflect.Type, bool) {
+	if info, ok := LookupSentenceInfo(sentenceType); ok && info.Prototype != nil {
+		return reflect.TypeOf(info.Prototype), true
+	}
+	var result reflect.Type
+	structCodecs.Range(func(_, value interface{}) bool {
+		if c := value.(*structCodec); c.sentenceType == sentenceType {
+			result = c.typ
+			return false
+		}
+		return true
+	})
+	return result, result != nil
+}
+
+// unmarshalSentenceInto decodes sentence JSON envelope into struct pointed by v
+func unmarshalSentenceInto(data []byte, v interface{}) error {
+	var envelope sentenceJSON
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("nmea: sentence can only be decoded into non-nil struct pointer")
+	}
+	target := rv.Elem()
+
+	base := BaseSentence{
+		Talker:   envelope.Talker,
+		Type:     envelope.Type,
+		Checksum: envelope.Checksum,
+		Raw:      envelope.Raw,
+	}
+	if len(envelope.TagBlock) > 0 && !bytes.Equal(envelope.TagBlock, jsonNull) {
+		if err := unmarshalTagBlockJSON(envelope.TagBlock, &base.TagBlock); err != nil {
+			return err
+		}
+	}
+	if envelope.Version != nil {
+		v, err := ParseVersion(*envelope.Version)
+		if err != nil {
+			return err
+		}
+		base.Version = v
+	}
+	if target.Type() == baseSentenceType {
+		target.Set(reflect.ValueOf(base))
+		return nil
+	}
+	if f, ok := target.Type().FieldByName("BaseSentence"); ok && f.Anonymous && f.Type == baseSentenceType {
+		target.FieldByIndex(f.Index).Set(reflect.ValueOf(base))
+	}
+	return unmarshalFields(envelope.Data, target)
+}
+
+// unmarshalStructJSON decodes JSON object with snake_case member names into struct
+// unmarshalTagBlockJSON decodes tag block object. Extra parameters are not a struct field of TagBlock and are decoded
+// from the "extra" member separately.
+func unmarshalTagBlockJSON(data []byte, t *TagBlock) error {
+	if err := unmarshalStructJSON(data, reflect.ValueOf(t).Elem()); err != nil {
+		return err
+	}
+	var members struct {
+		Extra TagBlockParams `json:"extra"`
+	}
+	if err := json.Unmarshal(data, &members); err != nil {
+		return fmt.Errorf("nmea: failed to decode JSON member extra: %w", err)
+	}
+	for _, param := range members.Extra {
+		t.SetExtra(param.Key, param.Value)
+	}
+	return nil
+}
+
+func unmarshalStructJSON(data []byte, v reflect.Value) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	return unmarshalFields(members, v)
+}
+
+func unmarshalFields(members map[string]json.RawMessage, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := v.Field(i)
+		if f.Anonymous {
+			if f.Type != baseSentenceType && fv.Kind() == reflect.Struct {
+				if err := unmarshalFields(members, fv); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+		raw, ok := members[snakeCase(f.Name)]
+		if !ok {
+			continue
+		}
+		if err := unmarshalValueJSON(raw, fv); err != nil {
+			return fmt.Errorf("nmea: failed to decode JSON member %s: %w", snakeCase(f.Name), err)
+		}
+	}
+	return nil
+}
+
+func unmarshalValueJSON(raw json.RawMessage, v reflect.Value) error {
+	if v.CanAddr() {
+		if _, ok := v.Addr().Interface().(json.Unmarshaler); ok {
+			return json.Unmarshal(raw, v.Addr().Interface())
+		}
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if bytes.Equal(raw, jsonNull) {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		p := reflect.New(v.Type().Elem())
+		if err := unmarshalValueJSON(raw, p.Elem()); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	case reflect.Struct:
+		if bytes.Equal(raw, jsonNull) {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		return unmarshalStructJSON(raw, v)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break // base64 encoded by encoding/json
+		}
+		if bytes.Equal(raw, jsonNull) {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		s := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := unmarshalValueJSON(item, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	case reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			if err := unmarshalValueJSON(items[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	p := reflect.New(v.Type())
+	if err := json.Unmarshal(raw, p.Interface()); err != nil {
+		return err
+	}
+	v.Set(p.Elem())
+	return nil
+}
+
+// JSONSchema returns JSON schema (draft-07) of the sentence type as encoded by MarshalSentence. Sentence type is
+// looked up as in UnmarshalSentence.
+func JSONSchema(sentenceType string) ([]byte, error) {
+	typ, ok := sentenceTypeOf(sentenceType)
+	if !ok {
+		return nil, &NotSupportedError{Prefix: sentenceType}
+	}
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	title := sentenceType
+	if info, ok := LookupSentenceInfo(sentenceType); ok && info.Description != "" {
+		title = sentenceType + " - " + info.Description
+	}
+	schema := map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"title":                title,
+		"type":                 "object",
+		"required":             []string{"type", "talker", "checksum", "raw", "tag_block", "data"},
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"type":      map[string]interface{}{"const": sentenceType},
+			"talker":    map[string]interface{}{"type": "string"},
+			"checksum":  map[string]interface{}{"type": "string"},
+			"raw":       map[string]interface{}{"type": "string"},
+			"tag_block": nullable(tagBlockSchema()),
+			"version": map[string]interface{}{
+				"type": "string",
+				"enum": []string{"2.x", "3.0", "4.0", "4.10", "4.11"},
+			},
+			"data": typeSchema(typ),
+		},
+	}
+	return json.Marshal(schema)
+}
+
+func typeSchema(t reflect.Type) map[string]interface{} {
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": []string{"string", "null"}, "pattern": `^\d{2}:\d{2}:\d{2}\.\d{3}$`}
+	case dateType:
+		return map[string]interface{}{"type": []string{"string", "null"}, "format": "date"}
+	case float64Type:
+		return map[string]interface{}{"type": []string{"number", "null"}}
+	case int64Type:
+		return map[string]interface{}{"type": []string{"integer", "null"}}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Ptr:
+		return nullable(typeSchema(t.Elem()))
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": []string{"string", "null"}, "contentEncoding": "base64"}
+		}
+		return map[string]interface{}{"type": []string{"array", "null"}, "items": typeSchema(t.Elem())}
+	case reflect.Array:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem()), "maxItems": t.Len()}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		structSchema(t, properties)
+		required := make([]string, 0, len(properties))
+		for k := range properties {
+			required = append(required, k)
+		}
+		sort.Strings(required)
+		return map[string]interface{}{"type": "object", "properties": properties, "required": required}
+	}
+	return map[string]interface{}{}
+}
+
+// tagBlockSchema returns schema of tag block object including the "extra" member
+func tagBlockSchema() map[string]interface{} {
+	schema := typeSchema(reflect.TypeOf(TagBlock{}))
+	schema["properties"].(map[string]interface{})["extra"] = typeSchema(reflect.TypeOf(TagBlockParams{}))
+	required := append(schema["required"].([]string), "extra")
+	sort.Strings(required)
+	schema["required"] = required
+	return schema
+}
+
+func structSchema(t reflect.Type, properties map[string]interface{}) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			if f.Type != baseSentenceType && f.Type.Kind() == reflect.Struct {
+				structSchema(f.Type, properties)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		properties[snakeCase(f.Name)] = typeSchema(f.Type)
+	}
+}
+
+func nullable(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"oneOf": []interface{}{map[string]interface{}{"type": "null"}, schema}}
+}
+
+// MarshalJSON encodes time as ISO 8601 time string (hh:mm:ss.sss), invalid time is encoded as null
+func (t Time) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return jsonNull, nil
+	}
+	return []byte(`"` + formatISOTime(t) + `"`), nil
+}
+
+// UnmarshalJSON decodes time from ISO 8601 time string (hh:mm:ss or hh:mm:ss.sss) or null
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*t = Time{}
+		return nil
+	}
+	m := jsonTimeRe.FindStringSubmatch(*s)
+	if m == nil {
+		return fmt.Errorf("nmea: invalid JSON time %q, expected hh:mm:ss.sss", *s)
+	}
+	result := Time{Valid: true}
+	result.Hour, _ = strconv.Atoi(m[1])
+	result.Minute, _ = strconv.Atoi(m[2])
+	result.Second, _ = strconv.Atoi(m[3])
+	result.Millisecond, _ = strconv.Atoi((m[4] + "000")[:3])
+	*t = result
+	return nil
+}
+
+// MarshalJSON encodes date as ISO 8601 date string (yyyy-mm-dd), invalid date is encoded as null. Two-digit years
+// before 80 are in 21st century (see ToMap).
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return jsonNull, nil
+	}
+	return []byte(`"` + formatISODate(d) + `"`), nil
+}
+
+// UnmarshalJSON decodes date from ISO 8601 date string (yyyy-mm-dd) or null
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*d = Date{}
+		return nil
+	}
+	m := jsonDateRe.FindStringSubmatch(*s)
+	if m == nil {
+		return fmt.Errorf("nmea: invalid JSON date %q, expected yyyy-mm-dd", *s)
+	}
+	year, _ := strconv.Atoi(m[1])
+	result := Date{Valid: true}
+	result.MM, _ = strconv.Atoi(m[2])
+	result.DD, _ = strconv.Atoi(m[3])
+	result.YY = year % 100
+	*d = result
+	return nil
+}
+
+// MarshalJSON encodes value as JSON number, invalid value and value that is not finite (NaN or infinity) are encoded
+// as null
+func (f Float64) MarshalJSON() ([]byte, error) {
+	if !f.Valid || !isFinite(f.Value) {
+		return jsonNull, nil
+	}
+	return json.Marshal(f.Value)
+}
+
+// UnmarshalJSON decodes value from JSON number or null
+func (f *Float64) UnmarshalJSON(data []byte) error {
+	var v *float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = Float64{}
+	if v != nil {
+		*f = Float64{Value: *v, Valid: true}
+	}
+	return nil
+}
+
+// MarshalJSON encodes value as JSON number, invalid value is encoded as null
+func (i Int64) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return jsonNull, nil
+	}
+	return []byte(strconv.FormatInt(i.Value, 10)), nil
+}
+
+// UnmarshalJSON decodes value from JSON number or null
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	var v *int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*i = Int64{}
+	if v != nil {
+		*i = Int64{Value: *v, Valid: true}
+	}
+	return nil
+}
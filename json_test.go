@@ -0,0 +1,260 @@
+package nmea
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalSentence(t *testing.T) {
+	s, err := Parse(`\s:Satelite_1,c:1553390539*62\$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C`)
+	assert.NoError(t, err)
+
+	b, err := json.Marshal(s)
+	assert.NoError(t, err)
+	expected := `{"type":"GGA","talker":"GN","checksum":"7C",` +
+		`"raw":"$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C",` +
+		`"tag_block":{"destination":"","extra":null,"grouping":"","line_count":0,"relative_time":0,` +
+		`"source":"Satelite_1","text":"","time":1553390539},` +
+		`"data":{"altitude":72.5,"dgps_age":"","dgps_id":"","fix_quality":"1","hdop":2.42,` +
+		`"latitude":63.42689666666667,"longitude":10.357149999999999,"num_satellites":8,"separation":41.5,` +
+		`"time":"20:34:15.000"}}`
+	assert.Equal(t, expected, string(b))
+
+	b2, err := MarshalSentence(s)
+	assert.NoError(t, err)
+	assert.Equal(t, b, b2)
+}
+
+func TestUnmarshalSentence_RoundTrip(t *testing.T) {
+	var testCases = []string{
+		"$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C",
+		"$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
+		"$GLGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*6B",
+		"$SDXDR,C,23.15,C,WTHI*70",
+		"!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55",
+		"$GNTXT,01,01,02,u-blox AG - www.u-blox.com*4E",
+		"$CDDSE,1,1,A,3380400790,00,46504437*15",
+		"$FBALC,02,01,03,01,FEB,01,02,03*0A",
+		"$WIMDA,3.02,I,1.01,B,23.4,C,,,40.2,,12.1,C,19.3,T,20.1,M,13.1,N,1.1,M*62",
+		"!RATTD,1A,01,1,177KQJ5000G?tO`K>RA1wUbN0TKH,0*72",
+		"$PCDIN,01F112,000C72EA,09,28C36A0000B40AFD*56",
+		"$GPGSA,A,3,22,19,18,27,14,03,,,,,,,3.1,2.0,2.4*36",
+		"$IIRTE,4,1,c,Rte 1,411,412,413,414,415*6F",
+		`\g:1-2-73874,n:157036,s:r003669945,c:1241544035*4A\!AIVDM,1,1,,B,15N4cJ005Jrek0H@9nDW5608EP8,0*2B`,
+		`\s:r3669961,c:1120959341,x:NorSat_1*6E\$GPHDT,274.07,T*03`,
+	}
+	for _, raw := range testCases {
+		t.Run(raw, func(t *testing.T) {
+			s, err := Parse(raw)
+			assert.NoError(t, err)
+
+			b, err := json.Marshal(s)
+			assert.NoError(t, err)
+
+			result, err := UnmarshalSentence(b)
+			assert.NoError(t, err)
+			assert.Equal(t, withoutFields(s), result)
+
+			// concrete type can be decoded with json.Unmarshal
+			concrete := reflect.New(reflect.TypeOf(s))
+			assert.NoError(t, json.Unmarshal(b, concrete.Interface()))
+			assert.Equal(t, withoutFields(s), concrete.Elem().Interface())
+		})
+	}
+}
+
+// withoutFields returns copy of the sentence with BaseSentence.Fields removed as they are not part of JSON
+func withoutFields(s Sentence) Sentence {
+	v := reflect.New(reflect.TypeOf(s)).Elem()
+	v.Set(reflect.ValueOf(s))
+	v.FieldByName("BaseSentence").FieldByName("Fields").Set(reflect.Zero(reflect.TypeOf([]string{})))
+	return v.Interface().(Sentence)
+}
+
+func TestUnmarshalSentence(t *testing.T) {
+	var testCases = []struct {
+		name      string
+		when      string
+		expect    Sentence
+		expectErr string
+	}{
+		{
+			name: "ok, missing members have zero value",
+			when: `{"type":"HDT","talker":"GP","data":{"heading":12.5}}`,
+			expect: HDT{
+				BaseSentence: BaseSentence{Talker: "GP", Type: TypeHDT},
+				Heading:      12.5,
+			},
+		},
+		{
+			name: "ok, proprietary sentence",
+			when: `{"type":"GRME","talker":"P","data":{"horizontal":1.5,"spherical":2}}`,
+			expect: PGRME{
+				BaseSentence: BaseSentence{Talker: "P", Type: TypePGRME},
+				Horizontal:   1.5,
+				Spherical:    2,
+			},
+		},
+		{
+			name:      "nok, unknown type",
+			when:      `{"type":"XXX","talker":"GP","data":{}}`,
+			expectErr: "nmea: sentence prefix 'XXX' not supported",
+		},
+		{
+			name:      "nok, invalid member value",
+			when:      `{"type":"HDT","talker":"GP","data":{"heading":"x"}}`,
+			expectErr: "nmea: failed to decode JSON member heading: json: cannot unmarshal string into Go value of type float64",
+		},
+		{
+			name:      "nok, invalid time",
+			when:      `{"type":"ZDA","talker":"GP","data":{"time":"25:00"}}`,
+			expectErr: `nmea: failed to decode JSON member time: nmea: invalid JSON time "25:00", expected hh:mm:ss.sss`,
+		},
+		{
+			name:      "nok, not JSON",
+			when:      `{"type":`,
+			expectErr: "unexpected end of JSON input",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := UnmarshalSentence([]byte(tc.when))
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				assert.Nil(t, s)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, s)
+		})
+	}
+}
+
+type jsonTestStructJJJ struct {
+	BaseSentence
+	Depth Float64 `nmea:"0,float"`
+	Label string  `nmea:"1,string"`
+}
+
+func TestUnmarshalSentence_StructParser(t *testing.T) {
+	_, err := StructParser("JJJ", jsonTestStructJJJ{})
+	assert.NoError(t, err)
+
+	s, err := UnmarshalSentence([]byte(`{"type":"JJJ","talker":"AA","data":{"depth":null,"label":"x"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, jsonTestStructJJJ{BaseSentence: BaseSentence{Talker: "AA", Type: "JJJ"}, Label: "x"}, s)
+}
+
+func TestSentenceJSONMethods(t *testing.T) {
+	// methods are generated for prototypes of the registry, run `go generate` when this fails
+	for _, sentenceType := range DefaultMetadataRegistry().Types() {
+		info, _ := LookupSentenceInfo(sentenceType)
+		if info.Prototype == nil {
+			continue
+		}
+		_, ok := info.Prototype.(json.Marshaler)
+		assert.True(t, ok, "%s does not implement json.Marshaler", sentenceType)
+		_, ok = reflect.New(reflect.TypeOf(info.Prototype)).Interface().(json.Unmarshaler)
+		assert.True(t, ok, "%s does not implement json.Unmarshaler", sentenceType)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	for _, sentenceType := range DefaultMetadataRegistry().Types() {
+		b, err := JSONSchema(sentenceType)
+		assert.NoError(t, err, sentenceType)
+		assert.True(t, json.Valid(b), sentenceType)
+	}
+
+	b, err := JSONSchema(TypeMTW)
+	assert.NoError(t, err)
+	var schema struct {
+		Title      string `json:"title"`
+		Properties struct {
+			Type struct {
+				Const string `json:"const"`
+			} `json:"type"`
+			Data struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"data"`
+		} `json:"properties"`
+	}
+	assert.NoError(t, json.Unmarshal(b, &schema))
+	assert.Equal(t, "MTW - mean temperature of water", schema.Title)
+	assert.Equal(t, TypeMTW, schema.Properties.Type.Const)
+	assert.Equal(t, map[string]map[string]interface{}{
+		"temperature":   {"type": "number"},
+		"celsius_valid": {"type": "boolean"},
+	}, schema.Properties.Data.Properties)
+
+	_, err = JSONSchema("XXX")
+	assert.EqualError(t, err, "nmea: sentence prefix 'XXX' not supported")
+}
+
+func TestNullableTypesJSON(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		value  interface{}
+		expect string
+	}{
+		{name: "valid time", value: Time{Valid: true, Hour: 1, Minute: 2, Second: 3, Millisecond: 4}, expect: `"01:02:03.004"`},
+		{name: "invalid time", value: Time{}, expect: `null`},
+		{name: "valid date", value: Date{Valid: true, DD: 13, MM: 6, YY: 94}, expect: `"1994-06-13"`},
+		{name: "invalid date", value: Date{}, expect: `null`},
+		{name: "valid float", value: Float64{Valid: true, Value: -1.25}, expect: `-1.25`},
+		{name: "invalid float", value: Float64{}, expect: `null`},
+		{name: "valid int", value: Int64{Valid: true, Value: 0}, expect: `0`},
+		{name: "invalid int", value: Int64{}, expect: `null`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, string(b))
+
+			decoded := reflect.New(reflect.TypeOf(tc.value))
+			assert.NoError(t, json.Unmarshal(b, decoded.Interface()))
+			assert.Equal(t, tc.value, decoded.Elem().Interface())
+		})
+	}
+}
+
+func TestFloat64JSON_NotFinite(t *testing.T) {
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		b, err := json.Marshal(Float64{Valid: true, Value: v})
+		assert.NoError(t, err)
+		assert.Equal(t, `null`, string(b))
+	}
+
+	b, err := json.Marshal(MTW{BaseSentence: BaseSentence{Talker: "YX", Type: TypeMTW}, Temperature: math.NaN()})
+	assert.Error(t, err)
+	assert.Nil(t, b)
+
+	_, err = Parse("$GPRMB,A,NaN,L,ORIG,DEST,4917.24,N,12309.57,W,001.3,052.5,000.5,V*4D")
+	assert.EqualError(t, err, "nmea: GPRMB invalid cross track error: NaN")
+}
+
+func TestNullableTypesJSON_Unmarshal(t *testing.T) {
+	var tm Time
+	assert.NoError(t, json.Unmarshal([]byte(`"23:59:60"`), &tm))
+	assert.Equal(t, Time{Valid: true, Hour: 23, Minute: 59, Second: 60}, tm)
+
+	assert.NoError(t, json.Unmarshal([]byte(`"12:00:01.5"`), &tm))
+	assert.Equal(t, Time{Valid: true, Hour: 12, Minute: 0, Second: 1, Millisecond: 500}, tm)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`"12:00"`), &tm), `nmea: invalid JSON time "12:00", expected hh:mm:ss.sss`)
+
+	var d Date
+	assert.NoError(t, json.Unmarshal([]byte(`"2024-02-29"`), &d))
+	assert.Equal(t, Date{Valid: true, DD: 29, MM: 2, YY: 24}, d)
+	assert.EqualError(t, json.Unmarshal([]byte(`"29.02.2024"`), &d), `nmea: invalid JSON date "29.02.2024", expected yyyy-mm-dd`)
+
+	var f Float64
+	assert.Error(t, json.Unmarshal([]byte(`"1.5"`), &f))
+	var i Int64
+	assert.Error(t, json.Unmarshal([]byte(`1.5`), &i))
+}
@@ -84,7 +84,7 @@ type MDA struct {
 }
 
 func newMDA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeMDA)
 	return MDA{
 		BaseSentence:          s,
@@ -110,3 +110,60 @@ func newMDA(s BaseSentence) (Sentence, error) {
 		MetersValid:           p.EnumString(19, "windspeed m/s valid", MetersSecondMDA) == MetersSecondMDA,
 	}, p.Err()
 }
+
+// Pressure returns barometric pressure. Pressure in bars is preferred. Returns false when pressure is not available.
+func (s MDA) Pressure() (Pressure, bool) {
+	if s.BarsValid {
+		return Pressure{Value: s.PressureBar, Unit: UnitBars}, true
+	}
+	if s.InchesValid {
+		return Pressure{Value: s.PressureInch, Unit: UnitInchesOfMercury}, true
+	}
+	return Pressure{}, false
+}
+
+// AirTemperature returns air temperature. Returns false when temperature is not available.
+func (s MDA) AirTemperature() (Temperature, bool) {
+	return Temperature{Value: s.AirTemp, Unit: UnitCelsius}, s.AirTempValid
+}
+
+// WaterTemperature returns water temperature. Returns false when temperature is not available.
+func (s MDA) WaterTemperature() (Temperature, bool) {
+	return Temperature{Value: s.WaterTemp, Unit: UnitCelsius}, s.WaterTempValid
+}
+
+// DewPointTemperature returns dew point. Returns false when dew point is not available.
+func (s MDA) DewPointTemperature() (Temperature, bool) {
+	return Temperature{Value: s.DewPoint, Unit: UnitCelsius}, s.DewPointValid
+}
+
+// WindSpeed returns wind speed. Speed in knots is preferred. Returns false when wind speed is not available.
+func (s MDA) WindSpeed() (Speed, bool) {
+	return windSpeedOf(s.WindSpeedKnots, s.KnotsValid, s.WindSpeedMeters, s.MetersValid)
+}
+
+// EncodeFields returns the MDA sentence fields in wire format
+func (s MDA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.PressureInch, 4)
+	e.Bool(s.InchesValid, InchMDA, "")
+	e.Float64(s.PressureBar, 4)
+	e.Bool(s.BarsValid, BarsMDA, "")
+	e.Float64(s.AirTemp, 3)
+	e.Bool(s.AirTempValid, DegreesCMDA, "")
+	e.Float64(s.WaterTemp, 3)
+	e.Bool(s.WaterTempValid, DegreesCMDA, "")
+	e.Float64(s.RelativeHum, 1)
+	e.Float64(s.AbsoluteHum, 1)
+	e.Float64(s.DewPoint, 3)
+	e.Bool(s.DewPointValid, DegreesCMDA, "")
+	e.Float64(s.WindDirectionTrue, 3)
+	e.Bool(s.TrueValid, TrueMDA, "")
+	e.Float64(s.WindDirectionMagnetic, 3)
+	e.Bool(s.MagneticValid, MagneticMDA, "")
+	e.Float64(s.WindSpeedKnots, 3)
+	e.Bool(s.KnotsValid, KnotsMDA, "")
+	e.Float64(s.WindSpeedMeters, 3)
+	e.Bool(s.MetersValid, MetersSecondMDA, "")
+	return e.Fields()
+}
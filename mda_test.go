@@ -55,3 +55,40 @@ func TestMDA(t *testing.T) {
 		})
 	}
 }
+
+func TestMDA_Accessors(t *testing.T) {
+	s, err := Parse("$WIMDA,3.02,I,1.01,B,23.4,C,,,40.2,,12.1,C,19.3,T,20.1,M,13.1,N,1.1,M*62")
+	assert.NoError(t, err)
+	mda := s.(MDA)
+
+	pressure, ok := mda.Pressure()
+	assert.True(t, ok)
+	assert.Equal(t, Pressure{Value: 1.01, Unit: UnitBars}, pressure)
+
+	air, ok := mda.AirTemperature()
+	assert.True(t, ok)
+	assert.Equal(t, Temperature{Value: 23.4, Unit: UnitCelsius}, air)
+
+	_, ok = mda.WaterTemperature()
+	assert.False(t, ok)
+
+	dewPoint, ok := mda.DewPointTemperature()
+	assert.True(t, ok)
+	assert.Equal(t, Temperature{Value: 12.1, Unit: UnitCelsius}, dewPoint)
+
+	wind, ok := mda.WindSpeed()
+	assert.True(t, ok)
+	assert.Equal(t, Speed{Value: 13.1, Unit: SpeedKnots}, wind)
+
+	s, err = Parse("$WIMDA,29.92,I,,,23.4,C,,,40.2,,12.1,C,19.3,T,20.1,M,,,1.1,M*5C")
+	assert.NoError(t, err)
+	mda = s.(MDA)
+
+	pressure, ok = mda.Pressure()
+	assert.True(t, ok)
+	assert.Equal(t, Pressure{Value: 29.92, Unit: UnitInchesOfMercury}, pressure)
+
+	wind, ok = mda.WindSpeed()
+	assert.True(t, ok)
+	assert.Equal(t, Speed{Value: 1.1, Unit: SpeedMeterPerSecond}, wind)
+}
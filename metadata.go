@@ -0,0 +1,192 @@
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// FieldKindString is kind of text field
+	FieldKindString = "string"
+	// FieldKindEnum is kind of field with fixed set of allowed values (see FieldInfo.Enum)
+	FieldKindEnum = "enum"
+	// FieldKindInt is kind of integer field
+	FieldKindInt = "int"
+	// FieldKindFloat is kind of decimal number field
+	FieldKindFloat = "float"
+	// FieldKindTime is kind of time field (hhmmss.ss)
+	FieldKindTime = "time"
+	// FieldKindDate is kind of date field (ddmmyy)
+	FieldKindDate = "date"
+	// FieldKindLatLong is kind of latitude or longitude value followed by direction field (N/S or E/W)
+	FieldKindLatLong = "latlong"
+	// FieldKindSixBit is kind of field with 6-bit ASCII armoured binary payload
+	FieldKindSixBit = "sixbit"
+)
+
+// SentenceInfo describes a sentence type: its form, data fields and the parser for it.
+type SentenceInfo struct {
+	// Type is the sentence type as returned by DataType (e.g. GGA). For proprietary sentences it is the type without
+	// the `P` prefix (e.g. GRME for PGRME).
+	Type string
+	// Description is short human-readable description of the sentence
+	Description string
+	// Proprietary is true for manufacturer proprietary sentences ($P...), talker ID of these sentences is `P`
+	Proprietary bool
+	// Encapsulated is true for sentences in encapsulation form (start delimiter `!`), false for sentences in
+	// parametric form (start delimiter `$`)
+	Encapsulated bool
+	// Fields describes data fields of the sentence in field order
+	Fields []FieldInfo
+	// Parser is used to parse sentences of this type
+	Parser ParserFunc
+	// Prototype is zero value of the sentence struct, used to decode sentences from JSON (see UnmarshalSentence).
+	// Optional.
+	Prototype Sentence
+}
+
+// FieldInfo describes a data field of sentence
+type FieldInfo struct {
+	// Index is index of the field in data fields (BaseSentence.Fields). Latitude/longitude direction is in the field
+	// following the index.
+	Index int
+	// Name is name of the field, same as used in parse errors (FieldError.Context)
+	Name string
+	// Kind is kind of the field value, one of FieldKind* constants
+	Kind string
+	// Unit is unit symbol of the value (for example "m", "kn", "deg"). Empty when value has no unit or when the unit
+	// is given by another field.
+	Unit string
+	// Enum contains allowed values of FieldKindEnum field
+	Enum []string
+	// Repeated is true when field is part of group of fields that repeats until the end of the sentence. Index is
+	// index of the field in the first group.
+	Repeated bool
+	// Since is NMEA 0183 version that introduced the field. VersionUnknown when field exists in all versions.
+	Since Version
+}
+
+// MetadataRegistry is a set of sentence type descriptions (SentenceInfo) by sentence type. SentenceParser looks up
+// parsers of sentence types without custom parser from it.
+//
+// MetadataRegistry is safe for concurrent use by multiple goroutines. Lookups do not lock: registered sentences are
+// kept in an immutable table that is replaced (copy-on-write) by Register and Unregister.
+type MetadataRegistry struct {
+	mu    sync.Mutex   // serialises writers
+	table atomic.Value // map[string]SentenceInfo
+}
+
+// NewMetadataRegistry creates new empty MetadataRegistry
+func NewMetadataRegistry() *MetadataRegistry {
+	r := &MetadataRegistry{}
+	r.table.Store(map[string]SentenceInfo{})
+	return r
+}
+
+func (r *MetadataRegistry) load() map[string]SentenceInfo {
+	t, _ := r.table.Load().(map[string]SentenceInfo)
+	return t
+}
+
+// Register registers sentence type. Registering already registered sentence type results in an error.
+func (r *MetadataRegistry) Register(info SentenceInfo) error {
+	if info.Type == "" {
+		return errors.New("nmea: sentence info type is empty")
+	}
+	if info.Parser == nil {
+		return fmt.Errorf("nmea: sentence info for type '%q' has no parser", info.Type)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.load()
+	if _, ok := current[info.Type]; ok {
+		return fmt.Errorf("nmea: sentence info for type '%q' already exists", info.Type)
+	}
+	t := make(map[string]SentenceInfo, len(current)+1)
+	for k, v := range current {
+		t[k] = v
+	}
+	t[info.Type] = info
+	r.table.Store(t)
+	return nil
+}
+
+// Unregister removes sentence type. Returns false when there was no such sentence type.
+func (r *MetadataRegistry) Unregister(sentenceType string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.load()
+	if _, ok := current[sentenceType]; !ok {
+		return false
+	}
+	t := make(map[string]SentenceInfo, len(current))
+	for k, v := range current {
+		if k != sentenceType {
+			t[k] = v
+		}
+	}
+	r.table.Store(t)
+	return true
+}
+
+// Lookup returns description of the sentence type. Fields of returned SentenceInfo must not be modified.
+func (r *MetadataRegistry) Lookup(sentenceType string) (SentenceInfo, bool) {
+	info, ok := r.load()[sentenceType]
+	return info, ok
+}
+
+// Types returns registered sentence types in sorted order
+func (r *MetadataRegistry) Types() []string {
+	t := r.load()
+	result := make([]string, 0, len(t))
+	for k := range t {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Clone returns a copy of the registry. Changes to the copy do not affect the original registry and vice versa.
+func (r *MetadataRegistry) Clone() *MetadataRegistry {
+	c := &MetadataRegistry{}
+	t := r.load()
+	if t == nil {
+		t = map[string]SentenceInfo{}
+	}
+	c.table.Store(t) // table is immutable so it can be shared
+	return c
+}
+
+// defaultMetadataRegistry contains built-in sentences and sentences registered with global RegisterSentenceInfo
+var defaultMetadataRegistry = newDefaultMetadataRegistry()
+
+func newDefaultMetadataRegistry() *MetadataRegistry {
+	r := NewMetadataRegistry()
+	for _, info := range builtinSentences {
+		if err := r.Register(info); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// DefaultMetadataRegistry returns the registry with built-in sentences. It is used by SentenceParser when
+// SentenceParser.Metadata is not set.
+func DefaultMetadataRegistry() *MetadataRegistry {
+	return defaultMetadataRegistry
+}
+
+// LookupSentenceInfo returns description of the sentence type from the default metadata registry
+func LookupSentenceInfo(sentenceType string) (SentenceInfo, bool) {
+	return defaultMetadataRegistry.Lookup(sentenceType)
+}
+
+// RegisterSentenceInfo registers sentence type with its description and parser to the default metadata registry
+func RegisterSentenceInfo(info SentenceInfo) error {
+	return defaultMetadataRegistry.Register(info)
+}
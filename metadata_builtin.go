@@ -0,0 +1,1301 @@
+package nmea
+
+// builtinSentences contains descriptions of sentences supported by this package. They are registered to the
+// default metadata registry.
+var builtinSentences = []SentenceInfo{
+	{
+		Type:        TypeAAM,
+		Description: "waypoint arrival alarm",
+		Parser:      newAAM,
+		Prototype:   AAM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "arrival circle entered status", Kind: FieldKindEnum, Enum: []string{WPStatusArrivalCircleEnteredA, WPStatusArrivalCircleEnteredV}},
+			{Index: 1, Name: "perpendicularly passed status", Kind: FieldKindEnum, Enum: []string{WPStatusPerpendicularPassedA, WPStatusPerpendicularPassedV}},
+			{Index: 2, Name: "arrival circle radius", Kind: FieldKindFloat},
+			{Index: 3, Name: "arrival circle radius units", Kind: FieldKindEnum, Enum: []string{DistanceUnitKilometre, DistanceUnitNauticalMile, DistanceUnitStatuteMile, DistanceUnitMetre}},
+			{Index: 4, Name: "destination waypoint ID", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:         TypeABM,
+		Description:  "AIS addressed binary and safety related message",
+		Encapsulated: true,
+		Parser:       newABM,
+		Prototype:    ABM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "message ID", Kind: FieldKindInt},
+			{Index: 3, Name: "MMSI", Kind: FieldKindString},
+			{Index: 4, Name: "channel", Kind: FieldKindString},
+			{Index: 5, Name: "VDL message number", Kind: FieldKindInt},
+			{Index: 6, Name: "payload", Kind: FieldKindSixBit},
+			{Index: 7, Name: "number of padding bits", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeACK,
+		Description: "acknowledge alarm",
+		Parser:      newACK,
+		Prototype:   ACK{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "alert identifier", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeACN,
+		Description: "alert command",
+		Parser:      newACN,
+		Prototype:   ACN{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "manufacturer mnemonic code", Kind: FieldKindString},
+			{Index: 2, Name: "alert identifier", Kind: FieldKindInt},
+			{Index: 3, Name: "alert instance", Kind: FieldKindInt},
+			{Index: 4, Name: "alert command", Kind: FieldKindEnum, Enum: []string{AlertCommandAcknowledge, AlertCommandRequestRepeatInformation, AlertCommandResponsibilityTransfer, AlertCommandSilence}},
+			{Index: 5, Name: "alarm state", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeALA,
+		Description: "system faults and alarms",
+		Parser:      newALA,
+		Prototype:   ALA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "system indicator", Kind: FieldKindString},
+			{Index: 2, Name: "subsystem indicator", Kind: FieldKindString},
+			{Index: 3, Name: "instance number", Kind: FieldKindInt},
+			{Index: 4, Name: "type", Kind: FieldKindInt},
+			{Index: 5, Name: "condition", Kind: FieldKindString},
+			{Index: 6, Name: "alarm acknowledgement state", Kind: FieldKindString},
+			{Index: 7, Name: "message", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeALC,
+		Description: "cyclic alert list",
+		Parser:      newALC,
+		Prototype:   ALC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "message ID", Kind: FieldKindInt},
+			{Index: 3, Name: "entries number", Kind: FieldKindInt},
+			{Index: 4, Name: "manufacturer mnemonic code", Kind: FieldKindString, Repeated: true},
+			{Index: 5, Name: "alert identifier", Kind: FieldKindInt, Repeated: true},
+			{Index: 6, Name: "alert instance", Kind: FieldKindInt, Repeated: true},
+			{Index: 7, Name: "revision counter", Kind: FieldKindInt, Repeated: true},
+		},
+	},
+	{
+		Type:        TypeALF,
+		Description: "alert sentence",
+		Parser:      newALF,
+		Prototype:   ALF{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "message ID", Kind: FieldKindInt},
+			{Index: 3, Name: "time", Kind: FieldKindTime},
+			{Index: 4, Name: "alarm category", Kind: FieldKindEnum, Enum: []string{"A", "B", "C"}},
+			{Index: 5, Name: "alarm priority", Kind: FieldKindEnum, Enum: []string{"E", "A", "C", "W"}},
+			{Index: 6, Name: "alarm state", Kind: FieldKindEnum, Enum: []string{"A", "S", "O", "U", "V", "N"}},
+			{Index: 7, Name: "manufacturer mnemonic code", Kind: FieldKindString},
+			{Index: 8, Name: "alert identifier", Kind: FieldKindInt},
+			{Index: 9, Name: "alert instance", Kind: FieldKindInt},
+			{Index: 10, Name: "revision counter", Kind: FieldKindInt},
+			{Index: 11, Name: "escalation counter", Kind: FieldKindInt},
+			{Index: 12, Name: "alert text", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeALR,
+		Description: "set alarm state",
+		Parser:      newALR,
+		Prototype:   ALR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "unique alarm number", Kind: FieldKindInt},
+			{Index: 2, Name: "alarm condition", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 3, Name: "alarm state", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 4, Name: "description", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeAPB,
+		Description: "autopilot sentence \"B\" for heading/tracking",
+		Parser:      newAPB,
+		Prototype:   APB{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "general warning", Kind: FieldKindEnum, Enum: []string{StatusWarningAClearORNotUsedAPB, StatusWarningASetAPB}},
+			{Index: 1, Name: "lock warning", Kind: FieldKindEnum, Enum: []string{StatusWarningBSetAPB, StatusWarningBClearAPB}},
+			{Index: 2, Name: "cross track error magnitude", Kind: FieldKindFloat},
+			{Index: 3, Name: "direction to steer", Kind: FieldKindEnum, Enum: []string{Left, Right}},
+			{Index: 4, Name: "cross track units", Kind: FieldKindEnum, Enum: []string{DistanceUnitKilometre, DistanceUnitNauticalMile, DistanceUnitStatuteMile, DistanceUnitMetre}},
+			{Index: 5, Name: "arrival circle entered status", Kind: FieldKindEnum, Enum: []string{WPStatusArrivalCircleEnteredA, WPStatusArrivalCircleEnteredV}},
+			{Index: 6, Name: "perpendicularly passed status", Kind: FieldKindEnum, Enum: []string{WPStatusPerpendicularPassedA, WPStatusPerpendicularPassedV}},
+			{Index: 7, Name: "origin bearing to destination", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "origin bearing to destination type", Kind: FieldKindEnum, Enum: []string{HeadingMagnetic, HeadingTrue}},
+			{Index: 9, Name: "destination waypoint ID", Kind: FieldKindString},
+			{Index: 10, Name: "present bearing to destination", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 11, Name: "present bearing to destination type", Kind: FieldKindEnum, Enum: []string{HeadingMagnetic, HeadingTrue}},
+			{Index: 12, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 13, Name: "heading type", Kind: FieldKindEnum, Enum: []string{HeadingMagnetic, HeadingTrue}},
+			{Index: 14, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeARC,
+		Description: "alert command refused",
+		Parser:      newARC,
+		Prototype:   ARC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "manufacturer mnemonic code", Kind: FieldKindString},
+			{Index: 2, Name: "alert identifier", Kind: FieldKindInt},
+			{Index: 3, Name: "alert instance", Kind: FieldKindInt},
+			{Index: 4, Name: "refused alert command", Kind: FieldKindEnum, Enum: []string{AlertCommandAcknowledge, AlertCommandRequestRepeatInformation, AlertCommandResponsibilityTransfer, AlertCommandSilence}},
+		},
+	},
+	{
+		Type:         TypeBBM,
+		Description:  "AIS broadcast binary message",
+		Encapsulated: true,
+		Parser:       newBBM,
+		Prototype:    BBM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "message ID", Kind: FieldKindInt},
+			{Index: 3, Name: "channel", Kind: FieldKindString},
+			{Index: 4, Name: "VDL message number", Kind: FieldKindInt},
+			{Index: 5, Name: "payload", Kind: FieldKindSixBit},
+			{Index: 6, Name: "number of padding bits", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeBEC,
+		Description: "bearing and distance to waypoint (dead reckoning)",
+		Parser:      newBEC,
+		Prototype:   BEC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 3, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 5, Name: "true bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 6, Name: "true bearing unit valid", Kind: FieldKindEnum, Enum: []string{BearingTrue}},
+			{Index: 7, Name: "magnetic bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "magnetic bearing unit valid", Kind: FieldKindEnum, Enum: []string{BearingMagnetic}},
+			{Index: 9, Name: "distance to waypoint is nautical miles", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 10, Name: "is distance to waypoint nautical miles valid", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+			{Index: 11, Name: "destination waypoint ID", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeBOD,
+		Description: "bearing waypoint to waypoint (origin to destination)",
+		Parser:      newBOD,
+		Prototype:   BOD{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true bearing type", Kind: FieldKindEnum, Enum: []string{BearingTrue}},
+			{Index: 2, Name: "magnetic bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "magnetic bearing type", Kind: FieldKindEnum, Enum: []string{BearingMagnetic}},
+			{Index: 4, Name: "destination waypoint ID", Kind: FieldKindString},
+			{Index: 5, Name: "origin waypoint ID", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeBWC,
+		Description: "bearing and distance to waypoint (great circle)",
+		Parser:      newBWC,
+		Prototype:   BWC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 3, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 5, Name: "true bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 6, Name: "true bearing type", Kind: FieldKindEnum, Enum: []string{BearingTrue}},
+			{Index: 7, Name: "magnetic bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "magnetic bearing type", Kind: FieldKindEnum, Enum: []string{BearingMagnetic}},
+			{Index: 9, Name: "distance to waypoint is nautical miles", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 10, Name: "is distance to waypoint nautical miles unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+			{Index: 11, Name: "destination waypoint ID", Kind: FieldKindString},
+			{Index: 12, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeBWR,
+		Description: "bearing and distance to waypoint (rhumb line)",
+		Parser:      newBWR,
+		Prototype:   BWR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 3, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 5, Name: "true bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 6, Name: "true bearing type", Kind: FieldKindEnum, Enum: []string{BearingTrue}},
+			{Index: 7, Name: "magnetic bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "magnetic bearing type", Kind: FieldKindEnum, Enum: []string{BearingMagnetic}},
+			{Index: 9, Name: "distance to waypoint is nautical miles", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 10, Name: "is distance to waypoint nautical miles unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+			{Index: 11, Name: "destination waypoint ID", Kind: FieldKindString},
+			{Index: 12, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeBWW,
+		Description: "bearing waypoint to waypoint (destination to origin)",
+		Parser:      newBWW,
+		Prototype:   BWW{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true bearing type", Kind: FieldKindEnum, Enum: []string{BearingTrue}},
+			{Index: 2, Name: "magnetic bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "magnetic bearing type", Kind: FieldKindEnum, Enum: []string{BearingMagnetic}},
+			{Index: 4, Name: "destination waypoint ID", Kind: FieldKindString},
+			{Index: 5, Name: "origin waypoint ID", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeDBK,
+		Description: "depth below keel",
+		Parser:      newDBK,
+		Prototype:   DBK{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "depth feet", Kind: FieldKindFloat, Unit: "ft"},
+			{Index: 1, Name: "depth feet unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitFeet}},
+			{Index: 2, Name: "depth meters", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 3, Name: "depth meters unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitMetre}},
+			{Index: 4, Name: "depth fathom", Kind: FieldKindFloat, Unit: "fathom"},
+			{Index: 5, Name: "depth fathom unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitFathom}},
+		},
+	},
+	{
+		Type:        TypeDBS,
+		Description: "depth below surface",
+		Parser:      newDBS,
+		Prototype:   DBS{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "depth feet", Kind: FieldKindFloat, Unit: "ft"},
+			{Index: 1, Name: "depth feet unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitFeet}},
+			{Index: 2, Name: "depth meters", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 3, Name: "depth feet unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitMetre}},
+			{Index: 4, Name: "depth fathoms", Kind: FieldKindFloat, Unit: "fathom"},
+			{Index: 5, Name: "depth fathom unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitFathom}},
+		},
+	},
+	{
+		Type:        TypeDBT,
+		Description: "depth below transducer",
+		Parser:      newDBT,
+		Prototype:   DBT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "depth_feet", Kind: FieldKindFloat, Unit: "ft"},
+			{Index: 2, Name: "depth_meters", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 4, Name: "depth_fathoms", Kind: FieldKindFloat, Unit: "fathom"},
+		},
+	},
+	{
+		Type:        TypeDOR,
+		Description: "door status detection",
+		Parser:      newDOR,
+		Prototype:   DOR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "message type", Kind: FieldKindEnum, Enum: []string{TypeSingleDoorDOR, TypeFaultDOR, TypeSectionDOR}},
+			{Index: 1, Name: "time", Kind: FieldKindTime},
+			{Index: 2, Name: "system indicator", Kind: FieldKindString},
+			{Index: 3, Name: "division indicator 1", Kind: FieldKindString},
+			{Index: 4, Name: "division indicator 2", Kind: FieldKindInt},
+			{Index: 5, Name: "door number or count", Kind: FieldKindInt},
+			{Index: 6, Name: "door state", Kind: FieldKindEnum, Enum: []string{DoorStatusOpenDOR, DoorStatusClosedDOR, DoorStatusFaultDOR}},
+			{Index: 7, Name: "switch setting mode", Kind: FieldKindEnum, Enum: []string{SwitchSettingHarbourModeDOR, SwitchSettingSeaModeDOR}},
+			{Index: 8, Name: "message", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeDPT,
+		Description: "depth of water",
+		Parser:      newDPT,
+		Prototype:   DPT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "depth", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 1, Name: "offset", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 2, Name: "range scale", Kind: FieldKindFloat, Unit: "m"},
+		},
+	},
+	{
+		Type:        TypeDSC,
+		Description: "digital selective calling information",
+		Parser:      newDSC,
+		Prototype:   DSC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "format specifier", Kind: FieldKindString},
+			{Index: 1, Name: "address", Kind: FieldKindString},
+			{Index: 2, Name: "category", Kind: FieldKindString},
+			{Index: 3, Name: "cause of the distress or first telecommand", Kind: FieldKindString},
+			{Index: 4, Name: "type of communication or second telecommand", Kind: FieldKindString},
+			{Index: 5, Name: "position or canal", Kind: FieldKindString},
+			{Index: 6, Name: "time or telephone", Kind: FieldKindString},
+			{Index: 7, Name: "MMSI", Kind: FieldKindString},
+			{Index: 8, Name: "distress cause", Kind: FieldKindString},
+			{Index: 9, Name: "acknowledgement", Kind: FieldKindEnum, Enum: []string{AcknowledgementRequestDSC, " " + AcknowledgementRequestDSC, AcknowledgementDSC, " " + AcknowledgementDSC, AcknowledgementNeitherDSC, " " + AcknowledgementNeitherDSC}},
+			{Index: 10, Name: "expansion indicator", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeDSE,
+		Description: "expanded digital selective calling",
+		Parser:      newDSE,
+		Prototype:   DSE{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "total number of sentences", Kind: FieldKindInt},
+			{Index: 1, Name: "sentence number", Kind: FieldKindInt},
+			{Index: 2, Name: "acknowledgement", Kind: FieldKindEnum, Enum: []string{AcknowledgementAutomaticDSE, AcknowledgementRequestDSE, AcknowledgementQueryDSE}},
+			{Index: 3, Name: "MMSI", Kind: FieldKindString},
+			{Index: 4, Name: "data set code", Kind: FieldKindString, Repeated: true},
+			{Index: 5, Name: "data set data", Kind: FieldKindString, Repeated: true},
+		},
+	},
+	{
+		Type:        TypeDTM,
+		Description: "datum reference",
+		Parser:      newDTM,
+		Prototype:   DTM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "local datum code", Kind: FieldKindString},
+			{Index: 1, Name: "local datum subcode", Kind: FieldKindString},
+			{Index: 2, Name: "latitude offset minutes", Kind: FieldKindFloat, Unit: "min"},
+			{Index: 3, Name: "latitude offset direction", Kind: FieldKindString},
+			{Index: 4, Name: "longitude offset minutes", Kind: FieldKindFloat, Unit: "min"},
+			{Index: 5, Name: "longitude offset direction", Kind: FieldKindString},
+			{Index: 6, Name: "altitude offset offset", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 7, Name: "datum name", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeEVE,
+		Description: "general event message",
+		Parser:      newEVE,
+		Prototype:   EVE{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "tag code", Kind: FieldKindString},
+			{Index: 2, Name: "event message text", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeFIR,
+		Description: "fire detection",
+		Parser:      newFIR,
+		Prototype:   FIR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "message type", Kind: FieldKindEnum, Enum: []string{TypeEventOrAlarmFIR, TypeFaultFIR, TypeDisablementFIR}},
+			{Index: 1, Name: "time", Kind: FieldKindTime},
+			{Index: 2, Name: "system indicator", Kind: FieldKindString},
+			{Index: 3, Name: "division indicator 1", Kind: FieldKindString},
+			{Index: 4, Name: "division indicator 2", Kind: FieldKindInt},
+			{Index: 5, Name: "fire detector number or count", Kind: FieldKindInt},
+			{Index: 6, Name: "condition", Kind: FieldKindEnum, Enum: []string{ConditionActivationFIR, ConditionNonActivationFIR, ConditionUnknownFIR}},
+			{Index: 7, Name: "alarm acknowledgement state", Kind: FieldKindEnum, Enum: []string{AlarmStateAcknowledgedFIR, AlarmStateNotAcknowledgedFIR}},
+			{Index: 8, Name: "message", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeGGA,
+		Description: "global positioning system fix data",
+		Parser:      newGGA,
+		Prototype:   GGA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 3, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 5, Name: "fix quality", Kind: FieldKindEnum, Enum: []string{Invalid, GPS, DGPS, PPS, RTK, FRTK, EST}},
+			{Index: 6, Name: "number of satellites", Kind: FieldKindInt},
+			{Index: 7, Name: "hdop", Kind: FieldKindFloat},
+			{Index: 8, Name: "altitude", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 10, Name: "separation", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 12, Name: "dgps age", Kind: FieldKindString},
+			{Index: 13, Name: "dgps id", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeGLL,
+		Description: "geographic position, latitude/longitude and time",
+		Parser:      newGLL,
+		Prototype:   GLL{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 2, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "time", Kind: FieldKindTime},
+			{Index: 5, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidGLL, InvalidGLL}},
+			{Index: 6, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeGNS,
+		Description: "GNSS fix data",
+		Parser:      newGNS,
+		Prototype:   GNS{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 3, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 5, Name: "mode", Kind: FieldKindEnum, Enum: []string{NoFixGNS, AutonomousGNS, DifferentialGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS, EstimatedGNS, ManualGNS, SimulatorGNS}},
+			{Index: 6, Name: "SVs", Kind: FieldKindInt},
+			{Index: 7, Name: "HDOP", Kind: FieldKindFloat},
+			{Index: 8, Name: "altitude", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 9, Name: "separation", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 10, Name: "age", Kind: FieldKindFloat},
+			{Index: 11, Name: "station", Kind: FieldKindInt},
+			{Index: 12, Name: "navigation status", Kind: FieldKindEnum, Enum: []string{NavStatusSafe, NavStatusCaution, NavStatusUnsafe, NavStatusNotValid}, Since: Version410},
+		},
+	},
+	{
+		Type:        TypeGSA,
+		Description: "GNSS DOP and active satellites",
+		Parser:      newGSA,
+		Prototype:   GSA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "selection mode", Kind: FieldKindEnum, Enum: []string{Auto, Manual}},
+			{Index: 1, Name: "fix type", Kind: FieldKindEnum, Enum: []string{FixNone, Fix2D, Fix3D}},
+			{Index: 2, Name: "satellite in view", Kind: FieldKindString, Repeated: true},
+			{Index: 14, Name: "pdop", Kind: FieldKindFloat},
+			{Index: 15, Name: "hdop", Kind: FieldKindFloat},
+			{Index: 16, Name: "vdop", Kind: FieldKindFloat},
+			{Index: 17, Name: "system ID", Kind: FieldKindInt, Since: Version410},
+			{Index: 18, Name: "signal ID", Kind: FieldKindInt, Since: Version411},
+		},
+	},
+	{
+		Type:        TypeGSV,
+		Description: "GNSS satellites in view",
+		Parser:      newGSV,
+		Prototype:   GSV{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "total number of messages", Kind: FieldKindInt},
+			{Index: 1, Name: "message number", Kind: FieldKindInt},
+			{Index: 2, Name: "number of SVs in view", Kind: FieldKindInt},
+			{Index: 3, Name: "SV prn number", Kind: FieldKindInt, Repeated: true},
+			{Index: 4, Name: "elevation", Kind: FieldKindInt, Unit: "deg", Repeated: true},
+			{Index: 5, Name: "azimuth", Kind: FieldKindInt, Unit: "deg", Repeated: true},
+			{Index: 6, Name: "SNR", Kind: FieldKindInt, Unit: "dB-Hz", Repeated: true},
+		},
+	},
+	{
+		Type:        TypeHBT,
+		Description: "heartbeat supervision",
+		Parser:      newHBT,
+		Prototype:   HBT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "interval", Kind: FieldKindFloat, Unit: "s"},
+			{Index: 1, Name: "operation status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 2, Name: "message ID", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeHDG,
+		Description: "heading, deviation and variation",
+		Parser:      newHDG,
+		Prototype:   HDG{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "deviation", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 2, Name: "deviation direction", Kind: FieldKindEnum, Enum: []string{East, West}},
+			{Index: 3, Name: "variation", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 4, Name: "variation direction", Kind: FieldKindEnum, Enum: []string{East, West}},
+		},
+	},
+	{
+		Type:        TypeHDM,
+		Description: "heading, magnetic",
+		Parser:      newHDM,
+		Prototype:   HDM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "magnetic", Kind: FieldKindEnum, Enum: []string{MagneticHDM}},
+		},
+	},
+	{
+		Type:        TypeHDT,
+		Description: "heading, true",
+		Parser:      newHDT,
+		Prototype:   HDT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true", Kind: FieldKindEnum, Enum: []string{"T"}},
+		},
+	},
+	{
+		Type:        TypeHSC,
+		Description: "heading steering command",
+		Parser:      newHSC,
+		Prototype:   HSC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true heading type", Kind: FieldKindEnum, Enum: []string{HeadingTrue}},
+			{Index: 2, Name: "magnetic heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "magnetic heading type", Kind: FieldKindEnum, Enum: []string{HeadingMagnetic}},
+		},
+	},
+	{
+		Type:        TypeMDA,
+		Description: "meteorological composite",
+		Parser:      newMDA,
+		Prototype:   MDA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "pressure in inch", Kind: FieldKindFloat, Unit: "inHg"},
+			{Index: 1, Name: "inches valid", Kind: FieldKindEnum, Enum: []string{InchMDA}},
+			{Index: 2, Name: "pressure in bar", Kind: FieldKindFloat, Unit: "bar"},
+			{Index: 3, Name: "bars valid", Kind: FieldKindEnum, Enum: []string{BarsMDA}},
+			{Index: 4, Name: "air temp", Kind: FieldKindFloat, Unit: "degC"},
+			{Index: 5, Name: "air temp valid", Kind: FieldKindEnum, Enum: []string{DegreesCMDA}},
+			{Index: 6, Name: "water temp", Kind: FieldKindFloat, Unit: "degC"},
+			{Index: 7, Name: "water temp valid", Kind: FieldKindEnum, Enum: []string{DegreesCMDA}},
+			{Index: 8, Name: "relative humidity", Kind: FieldKindFloat, Unit: "%"},
+			{Index: 9, Name: "absolute humidity", Kind: FieldKindFloat},
+			{Index: 10, Name: "dewpoint", Kind: FieldKindFloat, Unit: "degC"},
+			{Index: 11, Name: "dewpoint valid", Kind: FieldKindEnum, Enum: []string{DegreesCMDA}},
+			{Index: 12, Name: "wind direction true", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 13, Name: "wind direction true valid", Kind: FieldKindEnum, Enum: []string{TrueMDA}},
+			{Index: 14, Name: "wind direction magnetic", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 15, Name: "wind direction magnetic valid", Kind: FieldKindEnum, Enum: []string{MagneticMDA}},
+			{Index: 16, Name: "windspeed knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 17, Name: "windspeed knots valid", Kind: FieldKindEnum, Enum: []string{KnotsMDA}},
+			{Index: 18, Name: "windspeed m/s", Kind: FieldKindFloat, Unit: "m/s"},
+			{Index: 19, Name: "windspeed m/s valid", Kind: FieldKindEnum, Enum: []string{MetersSecondMDA}},
+		},
+	},
+	{
+		Type:        TypeMTA,
+		Description: "air temperature",
+		Parser:      newMTA,
+		Prototype:   MTA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "temperature", Kind: FieldKindFloat, Unit: "degC"},
+			{Index: 1, Name: "temperature unit", Kind: FieldKindEnum, Enum: []string{TemperatureCelsius}},
+		},
+	},
+	{
+		Type:        TypeMTW,
+		Description: "mean temperature of water",
+		Parser:      newMTW,
+		Prototype:   MTW{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "temperature", Kind: FieldKindFloat, Unit: "degC"},
+			{Index: 1, Name: "unit of measurement celsius", Kind: FieldKindEnum, Enum: []string{CelsiusMTW}},
+		},
+	},
+	{
+		Type:        TypeMWD,
+		Description: "wind direction and speed",
+		Parser:      newMWD,
+		Prototype:   MWD{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true wind direction", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true wind valid", Kind: FieldKindEnum, Enum: []string{TrueMWD}},
+			{Index: 2, Name: "magnetic wind direction", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "magnetic direction valid", Kind: FieldKindEnum, Enum: []string{MagneticMWD}},
+			{Index: 4, Name: "windspeed knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 5, Name: "windspeed knots valid", Kind: FieldKindEnum, Enum: []string{KnotsMWD}},
+			{Index: 6, Name: "windspeed m/s", Kind: FieldKindFloat, Unit: "m/s"},
+			{Index: 7, Name: "windspeed m/s valid", Kind: FieldKindEnum, Enum: []string{MetersSecondMWD}},
+		},
+	},
+	{
+		Type:        TypeMWV,
+		Description: "wind speed and angle",
+		Parser:      newMWV,
+		Prototype:   MWV{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "wind angle", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "reference", Kind: FieldKindEnum, Enum: []string{RelativeMWV, TheoreticalMWV}},
+			{Index: 2, Name: "wind speed", Kind: FieldKindFloat},
+			{Index: 3, Name: "wind speed unit", Kind: FieldKindEnum, Enum: []string{UnitKMHMWV, UnitMSMWV, UnitKnotsMWV, UnitSMilesHMWV}},
+			{Index: 4, Name: "status", Kind: FieldKindEnum, Enum: []string{ValidMWV, InvalidMWV}},
+		},
+	},
+	{
+		Type:        TypeOSD,
+		Description: "own ship data",
+		Parser:      newOSD,
+		Prototype:   OSD{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "heading status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 2, Name: "vessel course true", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "course reference", Kind: FieldKindEnum, Enum: []string{OSDReferenceBottomTrackingLog, OSDReferenceManual, OSDReferenceWaterReferenced, OSDReferenceRadarTracking, OSDReferencePositioningSystemGroundReference}},
+			{Index: 4, Name: "vessel speed", Kind: FieldKindFloat},
+			{Index: 5, Name: "speed reference", Kind: FieldKindEnum, Enum: []string{OSDReferenceBottomTrackingLog, OSDReferenceManual, OSDReferenceWaterReferenced, OSDReferenceRadarTracking, OSDReferencePositioningSystemGroundReference}},
+			{Index: 6, Name: "vessel set", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 7, Name: "vessel drift", Kind: FieldKindFloat},
+			{Index: 8, Name: "speed units", Kind: FieldKindEnum, Enum: []string{DistanceUnitKilometre, DistanceUnitNauticalMile, DistanceUnitStatuteMile}},
+		},
+	},
+	{
+		Type:        TypePCDIN,
+		Description: "NMEA 2000 message (SeaSmart.Net protocol)",
+		Proprietary: true,
+		Parser:      newPCDIN,
+		Prototype:   PCDIN{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "PGN", Kind: FieldKindString},
+			{Index: 1, Name: "timestamp", Kind: FieldKindString},
+			{Index: 2, Name: "source", Kind: FieldKindString},
+			{Index: 3, Name: "data", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePGN,
+		Description: "NMEA 2000 frame",
+		Parser:      newPGN,
+		Prototype:   PGN{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "PGN", Kind: FieldKindString},
+			{Index: 1, Name: "attributes", Kind: FieldKindString},
+			{Index: 2, Name: "data", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePGRME,
+		Description: "Garmin estimated position error",
+		Proprietary: true,
+		Parser:      newPGRME,
+		Prototype:   PGRME{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "horizontal error", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 1, Name: "horizontal error unit", Kind: FieldKindEnum, Enum: []string{ErrorUnit}},
+			{Index: 2, Name: "vertical error", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 3, Name: "vertical error unit", Kind: FieldKindEnum, Enum: []string{ErrorUnit}},
+			{Index: 4, Name: "spherical error", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 5, Name: "spherical error unit", Kind: FieldKindEnum, Enum: []string{ErrorUnit}},
+		},
+	},
+	{
+		Type:        TypePGRMT,
+		Description: "Garmin sensor status information",
+		Proprietary: true,
+		Parser:      newPGRMT,
+		Prototype:   PGRMT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "product, model and software version", Kind: FieldKindString},
+			{Index: 1, Name: "rom checksum test", Kind: FieldKindEnum, Enum: []string{PassPGRMT, FailPGRMT}},
+			{Index: 2, Name: "receiver failure discrete", Kind: FieldKindEnum, Enum: []string{PassPGRMT, FailPGRMT}},
+			{Index: 3, Name: "stored data lost", Kind: FieldKindEnum, Enum: []string{DataRetainedPGRMT, DataLostPGRMT}},
+			{Index: 4, Name: "realtime clock lost", Kind: FieldKindEnum, Enum: []string{DataRetainedPGRMT, DataLostPGRMT}},
+			{Index: 5, Name: "oscillator drift discrete", Kind: FieldKindEnum, Enum: []string{PassPGRMT, FailPGRMT}},
+			{Index: 6, Name: "data collection discrete", Kind: FieldKindEnum, Enum: []string{DataCollectingPGRMT}},
+			{Index: 7, Name: "sensor temperature in degrees celsius", Kind: FieldKindFloat, Unit: "degC"},
+			{Index: 8, Name: "sensor configuration data", Kind: FieldKindEnum, Enum: []string{DataRetainedPGRMT, DataLostPGRMT}},
+		},
+	},
+	{
+		Type:        TypePHTRO,
+		Description: "vessel pitch and roll",
+		Proprietary: true,
+		Parser:      newPHTRO,
+		Prototype:   PHTRO{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "pitch", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "bow", Kind: FieldKindEnum, Enum: []string{PHTROBowUP, PHTROBowDown}},
+			{Index: 2, Name: "roll", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "port", Kind: FieldKindEnum, Enum: []string{PHTROPortUP, PHTROPortDown}},
+		},
+	},
+	{
+		Type:        TypePKLDS,
+		Description: "Kenwood FleetSync position and status",
+		Proprietary: true,
+		Parser:      newPKLDS,
+		Prototype:   PKLDS{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidRMC, InvalidRMC}},
+			{Index: 2, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 6, Name: "speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 7, Name: "course", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "date", Kind: FieldKindDate},
+			{Index: 9, Name: "variation", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 10, Name: "sentance version, range of 00 to 15", Kind: FieldKindString},
+			{Index: 11, Name: "fleet, range of 100 to 349", Kind: FieldKindString},
+			{Index: 12, Name: "subscriber unit id, range of 1000 to 4999", Kind: FieldKindString},
+			{Index: 13, Name: "subscriber unit status id, range of 10 to 99", Kind: FieldKindString},
+			{Index: 14, Name: "reserved for future use, range of 00 to 99", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePKLID,
+		Description: "Kenwood FleetSync ID",
+		Proprietary: true,
+		Parser:      newPKLID,
+		Prototype:   PKLID{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "sentance version, range of 00 to 15", Kind: FieldKindString},
+			{Index: 1, Name: "fleet, range of 100 to 349", Kind: FieldKindString},
+			{Index: 2, Name: "subscriber unit id, range of 1000 to 4999", Kind: FieldKindString},
+			{Index: 3, Name: "subscriber unit status id, range of 10 to 99", Kind: FieldKindString},
+			{Index: 4, Name: "reserved for future use, range of 00 to 99", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePKLSH,
+		Description: "Kenwood FleetSync position",
+		Proprietary: true,
+		Parser:      newPKLSH,
+		Prototype:   PKLSH{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 2, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "time", Kind: FieldKindTime},
+			{Index: 5, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidGLL, InvalidGLL}},
+			{Index: 6, Name: "fleet, range of 100 to 349", Kind: FieldKindString},
+			{Index: 7, Name: "subscriber unit id, range of 1000 to 4999", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePKNDS,
+		Description: "Kenwood NEXTEDGE position and status",
+		Proprietary: true,
+		Parser:      newPKNDS,
+		Prototype:   PKNDS{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidRMC, InvalidRMC}},
+			{Index: 2, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 6, Name: "speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 7, Name: "course", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "date", Kind: FieldKindDate},
+			{Index: 9, Name: "variation", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 10, Name: "sentance version, range of 00 to 15", Kind: FieldKindString},
+			{Index: 11, Name: "unit ID, NXDN range U00001 to U65519, DMR range of  U00000001 to U16776415", Kind: FieldKindString},
+			{Index: 12, Name: "subscriber unit status id, range of 001 to 255", Kind: FieldKindString},
+			{Index: 13, Name: "reserved for future use, range of 00 to 99", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePKNID,
+		Description: "Kenwood NEXTEDGE ID",
+		Proprietary: true,
+		Parser:      newPKNID,
+		Prototype:   PKNID{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "sentance version, range of 00 to 15", Kind: FieldKindString},
+			{Index: 1, Name: "unit ID, NXDN range U00001 to U65519, DMR range of  U00000001 to U16776415", Kind: FieldKindString},
+			{Index: 2, Name: "status NXDN, range of 001 to 255", Kind: FieldKindString},
+			{Index: 3, Name: "reserved for future use, range of 00 to 99", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePKNSH,
+		Description: "Kenwood NEXTEDGE position",
+		Proprietary: true,
+		Parser:      newPKNSH,
+		Prototype:   PKNSH{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 2, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "time", Kind: FieldKindTime},
+			{Index: 5, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidGLL, InvalidGLL}},
+			{Index: 6, Name: "unit ID, NXDN range U00001 to U65519, DMR range of  U00000001 to U16776415", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePKWDWPL,
+		Description: "Kenwood waypoint location",
+		Proprietary: true,
+		Parser:      newPKWDWPL,
+		Prototype:   PKWDWPL{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidRMC, InvalidRMC}},
+			{Index: 2, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 6, Name: "speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 7, Name: "course", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "date", Kind: FieldKindDate},
+			{Index: 9, Name: "altitude", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 10, Name: "waypoint name, Object name/Sendin Station", Kind: FieldKindString},
+			{Index: 11, Name: "table and symbol as per APRS spec", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePMTK001,
+		Description: "MediaTek command acknowledgement",
+		Proprietary: true,
+		Parser:      newPMTK001,
+		Prototype:   PMTK001{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "command", Kind: FieldKindInt},
+			{Index: 1, Name: "flag", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypePRDID,
+		Description: "vessel pitch, roll and heading",
+		Proprietary: true,
+		Parser:      newPRDID,
+		Prototype:   PRDID{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "pitch", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "roll", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 2, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+		},
+	},
+	{
+		Type:        TypePSKPDPT,
+		Description: "depth of water for multiple transducers",
+		Proprietary: true,
+		Parser:      newPSKPDPT,
+		Prototype:   PSKPDPT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "depth", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 1, Name: "offset", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 2, Name: "range scale", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 3, Name: "bottom echo strength", Kind: FieldKindInt},
+			{Index: 4, Name: "channel number", Kind: FieldKindInt},
+			{Index: 5, Name: "transducer location", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypePSONCMS,
+		Description: "Xsens quaternion, acceleration, rate of turn, magnetic field and temperature",
+		Proprietary: true,
+		Parser:      newPSONCMS,
+		Prototype:   PSONCMS{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "q0 from quaternions", Kind: FieldKindFloat},
+			{Index: 1, Name: "q1 from quaternions", Kind: FieldKindFloat},
+			{Index: 2, Name: "q2 from quaternions", Kind: FieldKindFloat},
+			{Index: 3, Name: "q3 from quaternions", Kind: FieldKindFloat},
+			{Index: 4, Name: "acceleration X", Kind: FieldKindFloat},
+			{Index: 5, Name: "acceleration Y", Kind: FieldKindFloat},
+			{Index: 6, Name: "acceleration Z", Kind: FieldKindFloat},
+			{Index: 7, Name: "rate of turn X", Kind: FieldKindFloat},
+			{Index: 8, Name: "rate of turn Y", Kind: FieldKindFloat},
+			{Index: 9, Name: "rate of turn Z", Kind: FieldKindFloat},
+			{Index: 10, Name: "magnetic field X", Kind: FieldKindFloat},
+			{Index: 11, Name: "magnetic field Y", Kind: FieldKindFloat},
+			{Index: 12, Name: "magnetic field Z", Kind: FieldKindFloat},
+			{Index: 13, Name: "sensor temperature", Kind: FieldKindFloat},
+		},
+	},
+	{
+		Type:        TypeQuery,
+		Description: "query for sentence",
+		Parser:      newQuery,
+		Prototype:   Query{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "requested sentence", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeRMB,
+		Description: "recommended minimum navigation information",
+		Parser:      newRMB,
+		Prototype:   RMB{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "data status", Kind: FieldKindEnum, Enum: []string{DataStatusWarningClearRMB, DataStatusWarningSetRMB}},
+			{Index: 1, Name: "cross track error", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 2, Name: "direction to steer", Kind: FieldKindEnum, Enum: []string{Left, Right}},
+			{Index: 3, Name: "origin waypoint ID", Kind: FieldKindString},
+			{Index: 4, Name: "destination waypoint ID", Kind: FieldKindString},
+			{Index: 5, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 7, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 9, Name: "range to destination", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 10, Name: "true bearing to destination", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 11, Name: "velocity to destination", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 12, Name: "arrival status", Kind: FieldKindEnum, Enum: []string{WPStatusArrivalCircleEnteredA, WPStatusArrivalCircleEnteredV}},
+			{Index: 13, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeRMC,
+		Description: "recommended minimum specific GNSS data",
+		Parser:      newRMC,
+		Prototype:   RMC{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "validity", Kind: FieldKindEnum, Enum: []string{ValidRMC, InvalidRMC}},
+			{Index: 2, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 6, Name: "speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 7, Name: "course", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 8, Name: "date", Kind: FieldKindDate},
+			{Index: 9, Name: "variation", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 10, Name: "direction", Kind: FieldKindEnum, Enum: []string{West, East}},
+			{Index: 11, Name: "FAA mode", Kind: FieldKindString},
+			{Index: 12, Name: "navigation status", Kind: FieldKindEnum, Enum: []string{NavStatusSafe, NavStatusCaution, NavStatusUnsafe, NavStatusNotValid}, Since: Version410},
+		},
+	},
+	{
+		Type:        TypeROT,
+		Description: "rate of turn",
+		Parser:      newROT,
+		Prototype:   ROT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "rate of turn", Kind: FieldKindFloat, Unit: "deg/min"},
+			{Index: 1, Name: "status valid", Kind: FieldKindEnum, Enum: []string{ValidROT, InvalidROT}},
+		},
+	},
+	{
+		Type:        TypeRPM,
+		Description: "engine or shaft revolutions and pitch",
+		Parser:      newRPM,
+		Prototype:   RPM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "source", Kind: FieldKindEnum, Enum: []string{SourceEngineRPM, SourceShaftRPM}},
+			{Index: 1, Name: "engine number", Kind: FieldKindInt},
+			{Index: 2, Name: "speed", Kind: FieldKindFloat, Unit: "rpm"},
+			{Index: 3, Name: "pitch", Kind: FieldKindFloat, Unit: "%"},
+			{Index: 4, Name: "status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+		},
+	},
+	{
+		Type:        TypeRSA,
+		Description: "rudder sensor angle",
+		Parser:      newRSA,
+		Prototype:   RSA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "starboard rudder angle", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "starboard rudder angle status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 2, Name: "port rudder angle", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "port rudder angle status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+		},
+	},
+	{
+		Type:        TypeRSD,
+		Description: "radar system data",
+		Parser:      newRSD,
+		Prototype:   RSD{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "origin 1 range", Kind: FieldKindFloat},
+			{Index: 1, Name: "origin 1 bearing", Kind: FieldKindFloat},
+			{Index: 2, Name: "variable range marker 1", Kind: FieldKindFloat},
+			{Index: 3, Name: "bearing line 1", Kind: FieldKindFloat},
+			{Index: 4, Name: "origin 2 range", Kind: FieldKindFloat},
+			{Index: 5, Name: "origin 2 bearing", Kind: FieldKindFloat},
+			{Index: 6, Name: "variable range marker 2", Kind: FieldKindFloat},
+			{Index: 7, Name: "bearing line 2", Kind: FieldKindFloat},
+			{Index: 8, Name: "cursor range from own ship", Kind: FieldKindFloat},
+			{Index: 9, Name: "cursor bearing", Kind: FieldKindFloat},
+			{Index: 10, Name: "range scale", Kind: FieldKindFloat},
+			{Index: 11, Name: "range units", Kind: FieldKindEnum, Enum: []string{DistanceUnitKilometre, DistanceUnitNauticalMile, DistanceUnitStatuteMile}},
+			{Index: 12, Name: "display rotation", Kind: FieldKindEnum, Enum: []string{RSDDisplayRotationCourseUp, RSDDisplayRotationHeadingUp, RSDDisplayRotationNorthUp}},
+		},
+	},
+	{
+		Type:        TypeRTE,
+		Description: "routes",
+		Parser:      newRTE,
+		Prototype:   RTE{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of sentences", Kind: FieldKindInt},
+			{Index: 1, Name: "sentence number", Kind: FieldKindInt},
+			{Index: 2, Name: "active route or waypoint list", Kind: FieldKindEnum, Enum: []string{ActiveRoute, WaypointList}},
+			{Index: 3, Name: "name or number", Kind: FieldKindString},
+			{Index: 4, Name: "ident of waypoints", Kind: FieldKindString, Repeated: true},
+		},
+	},
+	{
+		Type:        TypeTHS,
+		Description: "true heading and status",
+		Parser:      newTHS,
+		Prototype:   THS{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "status", Kind: FieldKindEnum, Enum: []string{AutonomousTHS, EstimatedTHS, ManualTHS, SimulatorTHS, InvalidTHS}},
+		},
+	},
+	{
+		Type:        TypeTLB,
+		Description: "target label",
+		Parser:      newTLB,
+		Prototype:   TLB{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "target number", Kind: FieldKindFloat, Repeated: true},
+			{Index: 1, Name: "target label", Kind: FieldKindString, Repeated: true},
+		},
+	},
+	{
+		Type:        TypeTLL,
+		Description: "target latitude and longitude",
+		Parser:      newTLL,
+		Prototype:   TLL{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "target number", Kind: FieldKindInt},
+			{Index: 1, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 3, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 5, Name: "target name", Kind: FieldKindString},
+			{Index: 6, Name: "UTC time", Kind: FieldKindTime},
+			{Index: 7, Name: "target status", Kind: FieldKindEnum, Enum: []string{RadarTargetLost, RadarTargetAcquisition, RadarTargetTracking}},
+			{Index: 8, Name: "reference target", Kind: FieldKindEnum, Enum: []string{"R"}},
+		},
+	},
+	{
+		Type:         TypeTTD,
+		Description:  "tracked target data",
+		Encapsulated: true,
+		Parser:       newTTD,
+		Prototype:    TTD{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "sequence number", Kind: FieldKindInt},
+			{Index: 3, Name: "payload", Kind: FieldKindSixBit},
+			{Index: 4, Name: "number of padding bits", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeTTM,
+		Description: "tracked target message",
+		Parser:      newTTM,
+		Prototype:   TTM{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "target number", Kind: FieldKindInt},
+			{Index: 1, Name: "target Distance", Kind: FieldKindFloat},
+			{Index: 2, Name: "bearing", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "bearing type", Kind: FieldKindEnum, Enum: []string{"T", "R"}},
+			{Index: 4, Name: "target speed", Kind: FieldKindFloat},
+			{Index: 5, Name: "target course", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 6, Name: "course type", Kind: FieldKindEnum, Enum: []string{"T", "R"}},
+			{Index: 7, Name: "distance CPA", Kind: FieldKindFloat},
+			{Index: 8, Name: "time of CPA", Kind: FieldKindFloat, Unit: "min"},
+			{Index: 9, Name: "speed units", Kind: FieldKindEnum, Enum: []string{DistanceUnitKilometre, DistanceUnitNauticalMile, DistanceUnitStatuteMile}},
+			{Index: 10, Name: "target name", Kind: FieldKindString},
+			{Index: 11, Name: "target status", Kind: FieldKindEnum, Enum: []string{RadarTargetLost, RadarTargetAcquisition, RadarTargetTracking}},
+			{Index: 12, Name: "reference target", Kind: FieldKindEnum, Enum: []string{"R"}},
+			{Index: 13, Name: "UTC time", Kind: FieldKindTime},
+			{Index: 14, Name: "type of acquisition", Kind: FieldKindEnum, Enum: []string{"A", "M", "R"}},
+		},
+	},
+	{
+		Type:        TypeTXT,
+		Description: "text transmission",
+		Parser:      newTXT,
+		Prototype:   TXT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "total number of sentences", Kind: FieldKindInt},
+			{Index: 1, Name: "sentence number", Kind: FieldKindInt},
+			{Index: 2, Name: "sentence identifier", Kind: FieldKindInt},
+			{Index: 3, Name: "message", Kind: FieldKindString, Repeated: true},
+		},
+	},
+	{
+		Type:        TypeVBW,
+		Description: "dual ground/water speed",
+		Parser:      newVBW,
+		Prototype:   VBW{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "longitudinal water speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 1, Name: "transverse water speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 2, Name: "water speed status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 3, Name: "longitudinal ground speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 4, Name: "transverse ground speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 5, Name: "ground speed status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 6, Name: "stern traverse water speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 7, Name: "stern water speed status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+			{Index: 8, Name: "stern traverse ground speed", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 9, Name: "stern ground speed status", Kind: FieldKindEnum, Enum: []string{StatusValid, StatusInvalid}},
+		},
+	},
+	{
+		Type:         TypeVDM,
+		Description:  "AIS VHF data-link message",
+		Encapsulated: true,
+		Parser:       newVDMVDO,
+		Prototype:    VDMVDO{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "sequence number", Kind: FieldKindInt},
+			{Index: 3, Name: "channel ID", Kind: FieldKindString},
+			{Index: 4, Name: "payload", Kind: FieldKindSixBit},
+			{Index: 5, Name: "number of padding bits", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:         TypeVDO,
+		Description:  "AIS VHF data-link own-vessel report",
+		Encapsulated: true,
+		Parser:       newVDMVDO,
+		Prototype:    VDMVDO{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "number of fragments", Kind: FieldKindInt},
+			{Index: 1, Name: "fragment number", Kind: FieldKindInt},
+			{Index: 2, Name: "sequence number", Kind: FieldKindInt},
+			{Index: 3, Name: "channel ID", Kind: FieldKindString},
+			{Index: 4, Name: "payload", Kind: FieldKindSixBit},
+			{Index: 5, Name: "number of padding bits", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeVDR,
+		Description: "set and drift",
+		Parser:      newVDR,
+		Prototype:   VDR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true set degrees", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true set unit", Kind: FieldKindEnum, Enum: []string{BearingTrue}},
+			{Index: 2, Name: "magnetic set degrees", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 3, Name: "magnetic set unit", Kind: FieldKindEnum, Enum: []string{BearingMagnetic}},
+			{Index: 4, Name: "drift knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 5, Name: "drift unit", Kind: FieldKindEnum, Enum: []string{SpeedKnots}},
+		},
+	},
+	{
+		Type:        TypeVHW,
+		Description: "water speed and heading",
+		Parser:      newVHW,
+		Prototype:   VHW{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 2, Name: "magnetic heading", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 4, Name: "speed through water in knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 6, Name: "speed through water in kilometers per hour", Kind: FieldKindFloat, Unit: "km/h"},
+		},
+	},
+	{
+		Type:        TypeVLW,
+		Description: "distance travelled through water",
+		Parser:      newVLW,
+		Prototype:   VLW{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "total cumulative water distance", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 1, Name: "total cumulative water distance unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+			{Index: 2, Name: "water distance since reset", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 3, Name: "water distance since reset unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+			{Index: 4, Name: "total cumulative ground distance", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 5, Name: "total cumulative ground distance unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+			{Index: 6, Name: "ground distance since reset", Kind: FieldKindFloat, Unit: "nmi"},
+			{Index: 7, Name: "ground distance since reset unit", Kind: FieldKindEnum, Enum: []string{DistanceUnitNauticalMile}},
+		},
+	},
+	{
+		Type:        TypeVPW,
+		Description: "speed measured parallel to wind",
+		Parser:      newVPW,
+		Prototype:   VPW{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "wind speed in knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 1, Name: "wind speed in knots unit", Kind: FieldKindEnum, Enum: []string{SpeedKnots}},
+			{Index: 2, Name: "wind speed in meters per second", Kind: FieldKindFloat, Unit: "m/s"},
+			{Index: 3, Name: "wind speed in meters per second unit", Kind: FieldKindEnum, Enum: []string{SpeedMeterPerSecond}},
+		},
+	},
+	{
+		Type:        TypeVSD,
+		Description: "AIS voyage static data",
+		Parser:      newVSD,
+		Prototype:   VSD{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "type of ship and cargo", Kind: FieldKindInt},
+			{Index: 1, Name: "maximum present static draught", Kind: FieldKindFloat, Unit: "m"},
+			{Index: 2, Name: "persons on-board", Kind: FieldKindInt},
+			{Index: 3, Name: "destination", Kind: FieldKindString},
+			{Index: 4, Name: "estimated arrival time", Kind: FieldKindInt},
+			{Index: 5, Name: "estimated arrival day", Kind: FieldKindInt},
+			{Index: 6, Name: "estimated arrival month", Kind: FieldKindInt},
+			{Index: 7, Name: "navigational status", Kind: FieldKindInt},
+			{Index: 8, Name: "Regional application", Kind: FieldKindInt},
+		},
+	},
+	{
+		Type:        TypeVTG,
+		Description: "course over ground and ground speed",
+		Parser:      newVTG,
+		Prototype:   VTG{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true track", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 2, Name: "magnetic track", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 4, Name: "ground speed (knots)", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 6, Name: "ground speed (km/h)", Kind: FieldKindFloat, Unit: "km/h"},
+			{Index: 8, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeVWR,
+		Description: "relative wind speed and angle",
+		Parser:      newVWR,
+		Prototype:   VWR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "measured wind angle", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "measured wind direction to bow", Kind: FieldKindEnum, Enum: []string{Left, Right}},
+			{Index: 2, Name: "wind speed in knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 3, Name: "wind speed in knots unit", Kind: FieldKindEnum, Enum: []string{SpeedKnots}},
+			{Index: 4, Name: "wind speed in meters per second", Kind: FieldKindFloat, Unit: "m/s"},
+			{Index: 5, Name: "wind speed in meters per second unit", Kind: FieldKindEnum, Enum: []string{SpeedMeterPerSecond}},
+			{Index: 6, Name: "wind speed in kilometers per hour", Kind: FieldKindFloat, Unit: "km/h"},
+			{Index: 7, Name: "wind speed in kilometers per hour unit", Kind: FieldKindEnum, Enum: []string{SpeedKilometerPerHour}},
+		},
+	},
+	{
+		Type:        TypeVWT,
+		Description: "true wind speed and angle",
+		Parser:      newVWT,
+		Prototype:   VWT{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "true wind angle", Kind: FieldKindFloat, Unit: "deg"},
+			{Index: 1, Name: "true wind direction to bow", Kind: FieldKindEnum, Enum: []string{Left, Right}},
+			{Index: 2, Name: "wind speed in knots", Kind: FieldKindFloat, Unit: "kn"},
+			{Index: 3, Name: "wind speed in knots unit", Kind: FieldKindEnum, Enum: []string{SpeedKnots}},
+			{Index: 4, Name: "wind speed in meters per second", Kind: FieldKindFloat, Unit: "m/s"},
+			{Index: 5, Name: "wind speed in meters per second unit", Kind: FieldKindEnum, Enum: []string{SpeedMeterPerSecond}},
+			{Index: 6, Name: "wind speed in kilometers per hour", Kind: FieldKindFloat, Unit: "km/h"},
+			{Index: 7, Name: "wind speed in kilometers per hour unit", Kind: FieldKindEnum, Enum: []string{SpeedKilometerPerHour}},
+		},
+	},
+	{
+		Type:        TypeWPL,
+		Description: "waypoint location",
+		Parser:      newWPL,
+		Prototype:   WPL{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "latitude", Kind: FieldKindLatLong},
+			{Index: 2, Name: "longitude", Kind: FieldKindLatLong},
+			{Index: 4, Name: "ident of nth waypoint", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeXDR,
+		Description: "transducer measurement",
+		Parser:      newXDR,
+		Prototype:   XDR{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "transducer type", Kind: FieldKindEnum, Enum: []string{TransducerAngularDisplacementXDR, TransducerTemperatureXDR, TransducerDepthXDR, TransducerFrequencyXDR, TransducerHumidityXDR, TransducerForceXDR, TransducerPressureXDR, TransducerFlowXDR, TransducerAbsoluteHumidityXDR, TransducerGenericXDR, TransducerCurrentXDR, TransducerSalinityXDR, TransducerSwitchValveXDR, TransducerTachometerXDR, TransducerVoltageXDR, TransducerVolumeXDR}, Repeated: true},
+			{Index: 1, Name: "measurement value", Kind: FieldKindFloat, Repeated: true},
+			{Index: 2, Name: "measurement unit", Kind: FieldKindEnum, Enum: []string{UnitAmpere, UnitBars, UnitBinary, UnitCelsius, UnitDegrees, UnitHertz, UnitLitresPerSecond, UnitKelvin, UnitKilogramPerCubicMetre, UnitNewtons, UnitMeters, UnitCubicMeters, UnitRevolutionsPerMinute, UnitPercent, UnitPascal, UnitPartsPerThousand, UnitVolts}, Repeated: true},
+			{Index: 3, Name: "transducer name", Kind: FieldKindString, Repeated: true},
+		},
+	},
+	{
+		Type:        TypeXTE,
+		Description: "cross-track error, measured",
+		Parser:      newXTE,
+		Prototype:   XTE{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "general warning", Kind: FieldKindEnum, Enum: []string{StatusWarningAClearORNotUsedAPB, StatusWarningASetAPB}},
+			{Index: 1, Name: "lock warning", Kind: FieldKindEnum, Enum: []string{StatusWarningBSetAPB, StatusWarningBClearAPB}},
+			{Index: 2, Name: "cross track error magnitude", Kind: FieldKindFloat},
+			{Index: 3, Name: "direction to steer", Kind: FieldKindEnum, Enum: []string{Left, Right}},
+			{Index: 4, Name: "cross track units", Kind: FieldKindEnum, Enum: []string{DistanceUnitKilometre, DistanceUnitNauticalMile, DistanceUnitStatuteMile, DistanceUnitMetre}},
+			{Index: 5, Name: "FAA mode", Kind: FieldKindString},
+		},
+	},
+	{
+		Type:        TypeZDA,
+		Description: "time and date",
+		Parser:      newZDA,
+		Prototype:   ZDA{},
+		Fields: []FieldInfo{
+			{Index: 0, Name: "time", Kind: FieldKindTime},
+			{Index: 1, Name: "day", Kind: FieldKindInt},
+			{Index: 2, Name: "month", Kind: FieldKindInt},
+			{Index: 3, Name: "year", Kind: FieldKindInt},
+			{Index: 4, Name: "offset (hours)", Kind: FieldKindInt},
+			{Index: 5, Name: "offset (minutes)", Kind: FieldKindInt},
+		},
+	},
+}
@@ -0,0 +1,173 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupSentenceInfo(t *testing.T) {
+	var testCases = []struct {
+		name               string
+		whenType           string
+		expectDescription  string
+		expectProprietary  bool
+		expectEncapsulated bool
+		expectFieldCount   int
+	}{
+		{name: "ok, GGA", whenType: TypeGGA, expectDescription: "global positioning system fix data", expectFieldCount: 10},
+		{name: "ok, proprietary PGRME", whenType: TypePGRME, expectDescription: "Garmin estimated position error", expectProprietary: true, expectFieldCount: 6},
+		{name: "ok, encapsulated VDO", whenType: TypeVDO, expectDescription: "AIS VHF data-link own-vessel report", expectEncapsulated: true, expectFieldCount: 6},
+		{name: "ok, query", whenType: TypeQuery, expectDescription: "query for sentence", expectFieldCount: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, ok := LookupSentenceInfo(tc.whenType)
+			assert.True(t, ok)
+			assert.Equal(t, tc.whenType, info.Type)
+			assert.Equal(t, tc.expectDescription, info.Description)
+			assert.Equal(t, tc.expectProprietary, info.Proprietary)
+			assert.Equal(t, tc.expectEncapsulated, info.Encapsulated)
+			assert.Len(t, info.Fields, tc.expectFieldCount)
+			assert.NotNil(t, info.Parser)
+		})
+	}
+
+	_, ok := LookupSentenceInfo("XYZ")
+	assert.False(t, ok)
+}
+
+func TestLookupSentenceInfo_Fields(t *testing.T) {
+	info, ok := LookupSentenceInfo(TypeGGA)
+	assert.True(t, ok)
+	assert.Equal(t, FieldInfo{Index: 1, Name: "latitude", Kind: FieldKindLatLong}, info.Fields[1])
+	assert.Equal(t, FieldInfo{Index: 5, Name: "fix quality", Kind: FieldKindEnum, Enum: []string{Invalid, GPS, DGPS, PPS, RTK, FRTK, EST}}, info.Fields[3])
+	assert.Equal(t, FieldInfo{Index: 8, Name: "altitude", Kind: FieldKindFloat, Unit: "m"}, info.Fields[6])
+
+	info, ok = LookupSentenceInfo(TypeXDR)
+	assert.True(t, ok)
+	assert.Equal(t, FieldInfo{Index: 3, Name: "transducer name", Kind: FieldKindString, Repeated: true}, info.Fields[3])
+}
+
+func TestBuiltinSentences(t *testing.T) {
+	kinds := []string{
+		FieldKindString, FieldKindEnum, FieldKindInt, FieldKindFloat, FieldKindTime, FieldKindDate, FieldKindLatLong,
+		FieldKindSixBit,
+	}
+	for _, info := range builtinSentences {
+		t.Run(info.Type, func(t *testing.T) {
+			assert.NotEmpty(t, info.Description)
+			assert.Equal(t, info.Encapsulated, startDelimiterForType(info.Type) == SentenceStartEncapsulated)
+			last := -1
+			for _, f := range info.Fields {
+				assert.Greater(t, f.Index, last, f.Name)
+				assert.Contains(t, kinds, f.Kind, f.Name)
+				assert.Equal(t, f.Kind == FieldKindEnum, len(f.Enum) > 0, f.Name)
+				last = f.Index
+			}
+		})
+	}
+}
+
+func TestMetadataRegistry_Register(t *testing.T) {
+	r := NewMetadataRegistry()
+	assert.NoError(t, r.Register(SentenceInfo{Type: "XYZ", Parser: registryTestParser("a")}))
+	assert.NoError(t, r.Register(SentenceInfo{Type: "ABC", Parser: registryTestParser("b")}))
+
+	err := r.Register(SentenceInfo{Type: "XYZ", Parser: registryTestParser("c")})
+	assert.EqualError(t, err, `nmea: sentence info for type '"XYZ"' already exists`)
+
+	err = r.Register(SentenceInfo{Parser: registryTestParser("d")})
+	assert.EqualError(t, err, "nmea: sentence info type is empty")
+
+	err = r.Register(SentenceInfo{Type: "QQQ"})
+	assert.EqualError(t, err, `nmea: sentence info for type '"QQQ"' has no parser`)
+
+	assert.Equal(t, []string{"ABC", "XYZ"}, r.Types())
+
+	info, ok := r.Lookup("XYZ")
+	assert.True(t, ok)
+	s, _ := info.Parser(BaseSentence{})
+	assert.Equal(t, "a", s.(registryTestSentence).Parser)
+}
+
+func TestMetadataRegistry_UnregisterAndClone(t *testing.T) {
+	r := NewMetadataRegistry()
+	assert.NoError(t, r.Register(SentenceInfo{Type: "XYZ", Parser: registryTestParser("original")}))
+
+	c := r.Clone()
+	assert.NoError(t, c.Register(SentenceInfo{Type: "ABC", Parser: registryTestParser("clone")}))
+	assert.True(t, c.Unregister("XYZ"))
+	assert.False(t, c.Unregister("XYZ"))
+
+	assert.Equal(t, []string{"XYZ"}, r.Types())
+	assert.Equal(t, []string{"ABC"}, c.Types())
+}
+
+func TestMetadataRegistry_ZeroValue(t *testing.T) {
+	var r MetadataRegistry
+	_, ok := r.Lookup("XYZ")
+	assert.False(t, ok)
+	assert.Empty(t, r.Types())
+	assert.NoError(t, r.Register(SentenceInfo{Type: "XYZ", Parser: registryTestParser("a")}))
+	_, ok = r.Lookup("XYZ")
+	assert.True(t, ok)
+}
+
+func TestSentenceParser_Metadata(t *testing.T) {
+	r := DefaultMetadataRegistry().Clone()
+	assert.NoError(t, r.Register(SentenceInfo{Type: "YYY", Parser: registryTestParser("metadata")}))
+	assert.True(t, r.Unregister(TypeHDT))
+	p := SentenceParser{Metadata: r}
+
+	s, err := p.Parse("$AAYYY,20,one,*13")
+	assert.NoError(t, err)
+	assert.Equal(t, "metadata", s.(registryTestSentence).Parser)
+
+	_, err = p.Parse("$GPHDT,123.456,T*32")
+	assert.EqualError(t, err, "nmea: sentence prefix 'GPHDT' not supported")
+
+	s, err = p.Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	assert.Equal(t, TypeGGA, s.DataType())
+
+	// registry is consulted before metadata
+	p.Registry = NewParserRegistry()
+	assert.NoError(t, p.Registry.Register("", "YYY", registryTestParser("registry")))
+	s, err = p.Parse("$AAYYY,20,one,*13")
+	assert.NoError(t, err)
+	assert.Equal(t, "registry", s.(registryTestSentence).Parser)
+}
+
+func TestSentenceParser_MetadataStartDelimiter(t *testing.T) {
+	_, err := Parse("$AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25")
+	assert.EqualError(t, err, "nmea: sentence prefix 'AIVDM' not supported")
+
+	r := NewMetadataRegistry()
+	assert.NoError(t, r.Register(SentenceInfo{Type: "YYY", Encapsulated: true, Parser: registryTestParser("encapsulated")}))
+	p := SentenceParser{Metadata: r}
+
+	s, err := p.Parse("!AAYYY,20,one,*13")
+	assert.NoError(t, err)
+	assert.Equal(t, "encapsulated", s.(registryTestSentence).Parser)
+
+	_, err = p.Parse("$AAYYY,20,one,*13")
+	assert.EqualError(t, err, "nmea: sentence prefix 'AAYYY' not supported")
+}
+
+func TestRegisterSentenceInfo(t *testing.T) {
+	err := RegisterSentenceInfo(SentenceInfo{
+		Type:        "WWW",
+		Description: "test sentence",
+		Fields:      []FieldInfo{{Index: 0, Name: "number", Kind: FieldKindInt}},
+		Parser:      registryTestParser("global"),
+	})
+	assert.NoError(t, err)
+	defer DefaultMetadataRegistry().Unregister("WWW")
+
+	s, err := Parse("$AAWWW,20,one,*1D")
+	assert.NoError(t, err)
+	assert.Equal(t, "global", s.(registryTestSentence).Parser)
+
+	assert.Error(t, RegisterSentenceInfo(SentenceInfo{Type: TypeGGA, Parser: registryTestParser("duplicate")}))
+}
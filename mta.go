@@ -18,7 +18,7 @@ type MTA struct {
 
 // newMTA constructor
 func newMTA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeMTA)
 	return MTA{
 		BaseSentence: s,
@@ -26,3 +26,11 @@ func newMTA(s BaseSentence) (Sentence, error) {
 		Unit:         p.EnumString(1, "temperature unit", TemperatureCelsius),
 	}, p.Err()
 }
+
+// EncodeFields returns the MTA sentence fields in wire format
+func (s MTA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Temperature, 3)
+	e.String(s.Unit)
+	return e.Fields()
+}
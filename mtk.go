@@ -34,7 +34,7 @@ type MTK struct {
 // newMTK constructor
 // Deprecated: use newPMTK001 instead
 func newMTK(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeMTK)
 	cmd := p.Int64(0, "command")
 	flag := p.Int64(1, "flag")
@@ -44,3 +44,11 @@ func newMTK(s BaseSentence) (Sentence, error) {
 		Flag:         flag,
 	}, p.Err()
 }
+
+// EncodeFields returns the MTK sentence fields in wire format
+func (s MTK) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.Cmd)
+	e.Int64(s.Flag)
+	return e.Fields()
+}
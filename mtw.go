@@ -20,7 +20,7 @@ type MTW struct {
 
 // newMTW constructor
 func newMTW(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeMTW)
 	return MTW{
 		BaseSentence: s,
@@ -28,3 +28,16 @@ func newMTW(s BaseSentence) (Sentence, error) {
 		CelsiusValid: p.EnumString(1, "unit of measurement celsius", CelsiusMTW) == CelsiusMTW,
 	}, p.Err()
 }
+
+// WaterTemperature returns water temperature. Returns false when unit of the temperature is not Celsius.
+func (s MTW) WaterTemperature() (Temperature, bool) {
+	return Temperature{Value: s.Temperature, Unit: UnitCelsius}, s.CelsiusValid
+}
+
+// EncodeFields returns the MTW sentence fields in wire format
+func (s MTW) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Temperature, 3)
+	e.Bool(s.CelsiusValid, CelsiusMTW, "")
+	return e.Fields()
+}
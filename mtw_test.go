@@ -46,3 +46,16 @@ func TestMTW(t *testing.T) {
 		})
 	}
 }
+
+func TestMTW_WaterTemperature(t *testing.T) {
+	s, err := Parse("$INMTW,17.9,C*1B")
+	assert.NoError(t, err)
+	temperature, ok := s.(MTW).WaterTemperature()
+	assert.True(t, ok)
+	assert.Equal(t, Temperature{Value: 17.9, Unit: UnitCelsius}, temperature)
+
+	s, err = Parse("$INMTW,17.9,*58")
+	assert.NoError(t, err)
+	_, ok = s.(MTW).WaterTemperature()
+	assert.False(t, ok)
+}
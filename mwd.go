@@ -51,7 +51,7 @@ type MWD struct {
 }
 
 func newMWD(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeMWD)
 	return MWD{
 		BaseSentence:          s,
@@ -65,3 +65,36 @@ func newMWD(s BaseSentence) (Sentence, error) {
 		MetersValid:           p.EnumString(7, "windspeed m/s valid", MetersSecondMWD) == MetersSecondMWD,
 	}, p.Err()
 }
+
+// WindSpeed returns wind speed. Speed in knots is preferred. Returns false when wind speed is not available.
+func (s MWD) WindSpeed() (Speed, bool) {
+	return windSpeedOf(s.WindSpeedKnots, s.KnotsValid, s.WindSpeedMeters, s.MetersValid)
+}
+
+// FillDirections returns copy of the sentence with missing true or magnetic wind direction calculated from the other
+// direction and magnetic declination (degrees, positive east, see MagneticDeclination)
+func (s MWD) FillDirections(declination float64) MWD {
+	switch {
+	case !s.TrueValid && s.MagneticValid:
+		s.WindDirectionTrue = trueFromMagnetic(s.WindDirectionMagnetic, declination)
+		s.TrueValid = true
+	case !s.MagneticValid && s.TrueValid:
+		s.WindDirectionMagnetic = magneticFromTrue(s.WindDirectionTrue, declination)
+		s.MagneticValid = true
+	}
+	return s
+}
+
+// EncodeFields returns the MWD sentence fields in wire format
+func (s MWD) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.WindDirectionTrue, 3)
+	e.Bool(s.TrueValid, TrueMWD, "")
+	e.Float64(s.WindDirectionMagnetic, 3)
+	e.Bool(s.MagneticValid, MagneticMWD, "")
+	e.Float64(s.WindSpeedKnots, 3)
+	e.Bool(s.KnotsValid, KnotsMWD, "")
+	e.Float64(s.WindSpeedMeters, 3)
+	e.Bool(s.MetersValid, MetersSecondMWD, "")
+	return e.Fields()
+}
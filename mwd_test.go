@@ -57,3 +57,16 @@ func TestMWD(t *testing.T) {
 		})
 	}
 }
+
+func TestMWD_WindSpeed(t *testing.T) {
+	s, err := Parse("$WIMWD,10.1,T,10.1,M,12,N,40,M*5D")
+	assert.NoError(t, err)
+	speed, ok := s.(MWD).WindSpeed()
+	assert.True(t, ok)
+	assert.Equal(t, Speed{Value: 12, Unit: SpeedKnots}, speed)
+
+	s, err = Parse("$WIMWD,,,,,,,,*40")
+	assert.NoError(t, err)
+	_, ok = s.(MWD).WindSpeed()
+	assert.False(t, ok)
+}
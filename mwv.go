@@ -62,7 +62,7 @@ type MWV struct {
 }
 
 func newMWV(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeMWV)
 	return MWV{
 		BaseSentence:  s,
@@ -73,3 +73,25 @@ func newMWV(s BaseSentence) (Sentence, error) {
 		StatusValid:   p.EnumString(4, "status", ValidMWV, InvalidMWV) == ValidMWV,
 	}, p.Err()
 }
+
+// Angle returns wind angle. Returns false when angle field is empty.
+func (s MWV) Angle() (Angle, bool) {
+	return Angle{Value: s.WindAngle, Unit: UnitDegrees}, valuePresent(s.BaseSentence, 0, s.WindAngle)
+}
+
+// Speed returns wind speed. MWV speed units (K, M, N and S) are the same as Speed* units. Returns false when speed
+// or its unit is empty.
+func (s MWV) Speed() (Speed, bool) {
+	return Speed{Value: s.WindSpeed, Unit: s.WindSpeedUnit}, valuePresent(s.BaseSentence, 2, s.WindSpeed) && s.WindSpeedUnit != ""
+}
+
+// EncodeFields returns the MWV sentence fields in wire format
+func (s MWV) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.WindAngle, 3)
+	e.String(s.Reference)
+	e.Float64(s.WindSpeed, 3)
+	e.String(s.WindSpeedUnit)
+	e.Bool(s.StatusValid, ValidMWV, InvalidMWV)
+	return e.Fields()
+}
@@ -51,3 +51,29 @@ func TestMWV(t *testing.T) {
 		})
 	}
 }
+
+func TestMWV_Accessors(t *testing.T) {
+	s, err := Parse("$WIMWV,12.1,T,10.1,S,A*3A")
+	assert.NoError(t, err)
+	mwv := s.(MWV)
+	angle, ok := mwv.Angle()
+	assert.True(t, ok)
+	assert.Equal(t, Angle{Value: 12.1, Unit: UnitDegrees}, angle)
+	speed, ok := mwv.Speed()
+	assert.True(t, ok)
+	assert.Equal(t, Speed{Value: 10.1, Unit: SpeedStatuteMilePerHour}, speed)
+	assert.InDelta(t, 8.776660, speed.Knots(), 0.000001)
+
+	s, err = Parse("$WIMWV,,R,,,V*7A")
+	assert.NoError(t, err)
+	_, ok = s.(MWV).Angle()
+	assert.False(t, ok)
+	_, ok = s.(MWV).Speed()
+	assert.False(t, ok)
+
+	// speed without unit
+	s, err = Parse("$WIMWV,12.1,T,10.1,,A*69")
+	assert.NoError(t, err)
+	_, ok = s.(MWV).Speed()
+	assert.False(t, ok)
+}
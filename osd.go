@@ -69,7 +69,7 @@ type OSD struct {
 
 // newOSD constructor
 func newOSD(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeOSD)
 	m := OSD{
 		BaseSentence:     s,
@@ -101,3 +101,18 @@ func newOSD(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the OSD sentence fields in wire format
+func (s OSD) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Heading, 3)
+	e.String(s.HeadingStatus)
+	e.Float64(s.VesselTrueCourse, 3)
+	e.String(s.CourseReference)
+	e.Float64(s.VesselSpeed, 3)
+	e.String(s.SpeedReference)
+	e.Float64(s.VesselSetTrue, 3)
+	e.Float64(s.VesselDrift, 3)
+	e.String(s.SpeedUnits)
+	return e.Fields()
+}
@@ -2,14 +2,21 @@ package nmea
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 )
 
 // Parser provides a simple way of accessing and parsing
 // sentence fields
+//
+// Parser created by NewParser stops at the first error and later calls return zero values. Parsers of built-in
+// sentences continue after errors and collect all field errors (see FieldErrors), SentenceParser decides if all of
+// them are returned (see SentenceParser.Lenient).
 type Parser struct {
 	BaseSentence
-	err error
+	collect bool
+	err     error
+	errs    FieldErrors
 }
 
 // NewParser constructor
@@ -17,6 +24,11 @@ func NewParser(s BaseSentence) *Parser {
 	return &Parser{BaseSentence: s}
 }
 
+// newParser creates parser that collects all field errors
+func newParser(s BaseSentence) *Parser {
+	return &Parser{BaseSentence: s, collect: true}
+}
+
 // AssertType makes sure the sentence's type matches the provided one.
 func (p *Parser) AssertType(typ string) {
 	if p.Type != typ {
@@ -24,39 +36,71 @@ func (p *Parser) AssertType(typ string) {
 	}
 }
 
-// Err returns the first error encountered during the parser's usage.
+// Err returns the first error encountered during the parser's usage. Parser that collects field errors returns all
+// of them as FieldErrors.
 func (p *Parser) Err() error {
+	if p.collect && len(p.errs) > 0 {
+		return p.errs
+	}
 	return p.err
 }
 
 // SetErr assigns an error. Calling this method has no
 // effect if there is already an error.
 func (p *Parser) SetErr(context, value string) {
+	p.setFieldErr(&FieldError{Index: -1, Context: context, Value: value})
+}
+
+// setFieldErr assigns field error for the sentence. Calling this method has no effect if there is already an error,
+// parser that collects field errors adds the error to already collected errors.
+func (p *Parser) setFieldErr(err *FieldError) {
+	if p.collect {
+		err.Prefix = p.Prefix()
+		p.errs = append(p.errs, err)
+		return
+	}
 	if p.err == nil {
-		p.err = fmt.Errorf("nmea: %s invalid %s: %s", p.Prefix(), context, value)
+		err.Prefix = p.Prefix()
+		p.err = err
 	}
 }
 
+// stopped reports if parsing of fields is stopped by an error. Parser that collects field errors never stops.
+func (p *Parser) stopped() bool {
+	return p.err != nil && !p.collect
+}
+
+// invalidField assigns error for the field i with invalid value
+func (p *Parser) invalidField(i int, context, value string) {
+	p.setFieldErr(&FieldError{Index: i, Context: context, Value: value})
+}
+
 // String returns the field value at the specified index.
 func (p *Parser) String(i int, context string) string {
-	if p.err != nil {
-		return ""
+	s, _ := p.field(i, context)
+	return s
+}
+
+// field returns the field value at the specified index and reports if the value can be used
+func (p *Parser) field(i int, context string) (string, bool) {
+	if p.stopped() {
+		return "", false
 	}
 	if i < 0 || i >= len(p.Fields) {
-		p.SetErr(context, "index out of range")
-		return ""
+		p.setFieldErr(&FieldError{Index: i, Context: context, Reason: "index out of range"})
+		return "", false
 	}
-	return p.Fields[i]
+	return p.Fields[i], true
 }
 
 // ListString returns a list of all fields from the given start index.
 // An error occurs if there is no fields after the given start index.
 func (p *Parser) ListString(from int, context string) (list []string) {
-	if p.err != nil {
+	if p.stopped() {
 		return []string{}
 	}
 	if from < 0 || from >= len(p.Fields) {
-		p.SetErr(context, "index out of range")
+		p.setFieldErr(&FieldError{Index: from, Context: context, Reason: "index out of range"})
 		return []string{}
 	}
 	return append(list, p.Fields[from:]...)
@@ -65,8 +109,8 @@ func (p *Parser) ListString(from int, context string) (list []string) {
 // EnumString returns the field value at the specified index.
 // An error occurs if the value is not one of the options and not empty.
 func (p *Parser) EnumString(i int, context string, options ...string) string {
-	s := p.String(i, context)
-	if p.err != nil || s == "" {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return ""
 	}
 	for _, o := range options {
@@ -74,7 +118,7 @@ func (p *Parser) EnumString(i int, context string, options ...string) string {
 			return s
 		}
 	}
-	p.SetErr(context, s)
+	p.invalidField(i, context, s)
 	return ""
 }
 
@@ -82,8 +126,8 @@ func (p *Parser) EnumString(i int, context string, options ...string) string {
 // It will only match the number of characters that are in the Mode field.
 // If the value is empty, it will return an empty array
 func (p *Parser) EnumChars(i int, context string, options ...string) []string {
-	s := p.String(i, context)
-	if p.err != nil || s == "" {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return []string{}
 	}
 	strs := []string{}
@@ -98,7 +142,7 @@ func (p *Parser) EnumChars(i int, context string, options ...string) []string {
 	}
 	if len(strs) != len(s) {
 
-		p.SetErr(context, s)
+		p.invalidField(i, context, s)
 		return []string{}
 	}
 	return strs
@@ -107,8 +151,8 @@ func (p *Parser) EnumChars(i int, context string, options ...string) []string {
 // HexInt64 returns the hex encoded int64 value at the specified index.
 // If the value is an empty string, 0 is returned.
 func (p *Parser) HexInt64(i int, context string) int64 {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return 0
 	}
 	if s == "" {
@@ -116,7 +160,7 @@ func (p *Parser) HexInt64(i int, context string) int64 {
 	}
 	value, err := strconv.ParseInt(s, 16, 64)
 	if err != nil {
-		p.SetErr(context, s)
+		p.invalidField(i, context, s)
 	}
 	return value
 }
@@ -130,8 +174,8 @@ func (p *Parser) Int64(i int, context string) int64 {
 // NullInt64 returns the int64 value at the specified index.
 // If the value is an empty string, Valid is set to false
 func (p *Parser) NullInt64(i int, context string) Int64 {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return Int64{}
 	}
 	if s == "" {
@@ -139,7 +183,7 @@ func (p *Parser) NullInt64(i int, context string) Int64 {
 	}
 	v, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
-		p.SetErr(context, s)
+		p.invalidField(i, context, s)
 		return Int64{}
 	}
 	return Int64{Value: v, Valid: true}
@@ -154,16 +198,17 @@ func (p *Parser) Float64(i int, context string) float64 {
 // NullFloat64 returns the Float64 value at the specified index.
 // If the value is an empty string, Valid is set to false.
 func (p *Parser) NullFloat64(i int, context string) Float64 {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return Float64{}
 	}
 	if s == "" {
 		return Float64{}
 	}
 	v, err := strconv.ParseFloat(s, 64)
-	if err != nil {
-		p.SetErr(context, s)
+	if err != nil || !isFinite(v) {
+		// NaN and Inf are accepted by strconv.ParseFloat but are not valid NMEA numbers
+		p.invalidField(i, context, s)
 		return Float64{}
 	}
 	return Float64{Value: v, Valid: true}
@@ -172,13 +217,13 @@ func (p *Parser) NullFloat64(i int, context string) Float64 {
 // Time returns the Time value at the specified index.
 // If the value is empty, the Time is marked as invalid.
 func (p *Parser) Time(i int, context string) Time {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return Time{}
 	}
 	v, err := ParseTime(s)
 	if err != nil {
-		p.SetErr(context, s)
+		p.invalidField(i, context, s)
 	}
 	return v
 }
@@ -186,48 +231,85 @@ func (p *Parser) Time(i int, context string) Time {
 // Date returns the Date value at the specified index.
 // If the value is empty, the Date is marked as invalid.
 func (p *Parser) Date(i int, context string) Date {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return Date{}
 	}
 	v, err := ParseDate(s)
 	if err != nil {
-		p.SetErr(context, s)
+		p.invalidField(i, context, s)
 	}
 	return v
 }
 
 // LatLong returns the coordinate value of the specified fields.
 func (p *Parser) LatLong(i, j int, context string) float64 {
-	a := p.String(i, context)
-	b := p.String(j, context)
-	if p.err != nil {
+	a, okA := p.field(i, context)
+	b, okB := p.field(j, context)
+	if !okA || !okB {
 		return 0
 	}
-	s := fmt.Sprintf("%s %s", a, b)
-	v, err := ParseLatLong(s)
-	if err != nil {
-		p.SetErr(context, err.Error())
+	v, ok := parseNMEALatLong(a, b)
+	if !ok {
+		s := fmt.Sprintf("%s %s", a, b)
+		var err error
+		v, err = ParseLatLong(s)
+		if err != nil {
+			p.setFieldErr(&FieldError{Index: i, Context: context, Value: s, Reason: err.Error()})
+		}
 	}
 
+	if !isFinite(v) {
+		p.invalidField(i, context, a+" "+b)
+		return 0
+	}
 	if (b == North || b == South) && (v < -90.0 || 90.0 < v) {
-		p.SetErr(context, "latitude is not in range (-90, 90)")
+		p.setFieldErr(&FieldError{Index: i, Context: context, Value: a + " " + b, Reason: "latitude is not in range (-90, 90)"})
 		return 0
 	} else if (b == West || b == East) && (v < -180.0 || 180.0 < v) {
-		p.SetErr(context, "longitude is not in range (-180, 180)")
+		p.setFieldErr(&FieldError{Index: i, Context: context, Value: a + " " + b, Reason: "longitude is not in range (-180, 180)"})
 		return 0
 	}
 
 	return v
 }
 
+// isFinite reports whether v is neither NaN nor infinity
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// parseNMEALatLong parses coordinate in NMEA (ddmm.mmmm, N/S/E/W) format without allocating memory. Returns false
+// when value is not in NMEA format.
+func parseNMEALatLong(value, direction string) (float64, bool) {
+	if direction != North && direction != South && direction != East && direction != West {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	degrees := math.Floor(v / 100)
+	minutes := v - (degrees * 100)
+	v = degrees + minutes/60
+	if direction == South || direction == West {
+		return 0 - v, true
+	}
+	return v, true
+}
+
 // SixBitASCIIArmour decodes the 6-bit ascii armor used for VDM and VDO messages
 func (p *Parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
-	if p.err != nil {
+	return p.sixBitASCIIArmour(nil, i, fillBits, context)
+}
+
+// sixBitASCIIArmour decodes the 6-bit ascii armor into dst when it has enough capacity
+func (p *Parser) sixBitASCIIArmour(dst []byte, i int, fillBits int, context string) []byte {
+	if p.stopped() {
 		return nil
 	}
 	if fillBits < 0 || fillBits >= 6 {
-		p.SetErr(context, "fill bits")
+		p.setFieldErr(&FieldError{Index: i, Context: context, Reason: "fill bits"})
 		return nil
 	}
 
@@ -235,16 +317,21 @@ func (p *Parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
 	numBits := len(payload)*6 - fillBits
 
 	if numBits < 0 {
-		p.SetErr(context, "num bits")
+		p.setFieldErr(&FieldError{Index: i, Context: context, Value: string(payload), Reason: "num bits"})
 		return nil
 	}
 
-	result := make([]byte, numBits)
+	var result []byte
+	if dst != nil && cap(dst) >= numBits {
+		result = dst[:numBits]
+	} else {
+		result = make([]byte, numBits)
+	}
 	resultIndex := 0
 
 	for _, v := range payload {
 		if v < 48 || v >= 120 {
-			p.SetErr(context, "data byte")
+			p.setFieldErr(&FieldError{Index: i, Context: context, Value: string(payload), Reason: "data byte"})
 			return nil
 		}
 
@@ -180,6 +180,24 @@ func TestParser(t *testing.T) {
 				return p.Float64(0, "context")
 			},
 		},
+		{
+			name:     "Float64 NaN",
+			fields:   []string{"NaN"},
+			expected: float64(0),
+			hasErr:   true,
+			parse: func(p *Parser) interface{} {
+				return p.Float64(0, "context")
+			},
+		},
+		{
+			name:     "NullFloat64 infinity",
+			fields:   []string{"-Inf"},
+			expected: Float64{},
+			hasErr:   true,
+			parse: func(p *Parser) interface{} {
+				return p.NullFloat64(0, "context")
+			},
+		},
 		{
 			name:     "Float64 with existing error",
 			fields:   []string{"123.123"},
@@ -347,6 +365,15 @@ func TestParser(t *testing.T) {
 				return p.LatLong(0, 1, "context")
 			},
 		},
+		{
+			name:     "LatLong - not a number",
+			fields:   []string{"NaN", "N"},
+			expected: 0.0,
+			hasErr:   true,
+			parse: func(p *Parser) interface{} {
+				return p.LatLong(0, 1, "context")
+			},
+		},
 		{
 			name:     "LatLong - longitude out of range",
 			fields:   []string{"18100.0000", "W"},
@@ -429,3 +456,27 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_Lenient(t *testing.T) {
+	p := newParser(BaseSentence{
+		Talker: "talker",
+		Type:   "type",
+		Fields: []string{"x", "1.5", "abc", "4"},
+	})
+	assert.Equal(t, int64(0), p.Int64(0, "first"))
+	assert.Equal(t, 1.5, p.Float64(1, "second"))
+	assert.Equal(t, Float64{}, p.NullFloat64(2, "third"))
+	assert.Equal(t, Int64{Value: 4, Valid: true}, p.NullInt64(3, "fourth"))
+	assert.Equal(t, "", p.String(4, "fifth"))
+
+	err := p.Err()
+	assert.EqualError(t, err, "nmea: talkertype invalid first: x; "+
+		"nmea: talkertype invalid third: abc; "+
+		"nmea: talkertype invalid fifth: index out of range")
+
+	fieldErrs, ok := err.(FieldErrors)
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 4}, fieldErrs.Indices())
+	assert.True(t, fieldErrs.Invalid(2))
+	assert.False(t, fieldErrs.Invalid(1))
+}
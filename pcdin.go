@@ -2,7 +2,6 @@ package nmea
 
 import (
 	"encoding/hex"
-	"fmt"
 	"strconv"
 )
 
@@ -28,7 +27,7 @@ type PCDIN struct {
 
 // newPCDIN constructor
 func newPCDIN(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePCDIN)
 
 	if len(p.Fields) != 4 {
@@ -37,23 +36,19 @@ func newPCDIN(s BaseSentence) (Sentence, error) {
 	}
 	pgn, err := strconv.ParseUint(p.Fields[0], 16, 24)
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to parse PGN field: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 0, Context: "PGN", Value: p.Fields[0], Reason: "failed to parse PGN field", Err: err})
 	}
 	timestamp, err := strconv.ParseUint(p.Fields[1], 16, 32)
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to parse timestamp field: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 1, Context: "timestamp", Value: p.Fields[1], Reason: "failed to parse timestamp field", Err: err})
 	}
 	source, err := strconv.ParseUint(p.Fields[2], 16, 8)
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to parse source field: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 2, Context: "source", Value: p.Fields[2], Reason: "failed to parse source field", Err: err})
 	}
 	data, err := hex.DecodeString(p.Fields[3])
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to decode data: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 3, Context: "data", Value: p.Fields[3], Reason: "failed to decode data", Err: err})
 	}
 
 	return PCDIN{
@@ -64,3 +59,13 @@ func newPCDIN(s BaseSentence) (Sentence, error) {
 		Data:         data,
 	}, p.Err()
 }
+
+// EncodeFields returns the PCDIN sentence fields in wire format
+func (s PCDIN) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.HexInt64(int64(s.PGN), 6)
+	e.HexInt64(int64(s.Timestamp), 8)
+	e.HexInt64(int64(s.Source), 2)
+	e.Hex(s.Data)
+	return e.Fields()
+}
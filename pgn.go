@@ -2,7 +2,6 @@ package nmea
 
 import (
 	"encoding/hex"
-	"fmt"
 	"strconv"
 )
 
@@ -27,7 +26,7 @@ type PGN struct {
 
 // newPGN constructor
 func newPGN(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePGN)
 
 	if len(p.Fields) != 3 {
@@ -36,23 +35,19 @@ func newPGN(s BaseSentence) (Sentence, error) {
 	}
 	pgn, err := strconv.ParseUint(p.Fields[0], 16, 24)
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to parse PGN field: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 0, Context: "PGN", Value: p.Fields[0], Reason: "failed to parse PGN field", Err: err})
 	}
 	attributes, err := strconv.ParseUint(p.Fields[1], 16, 16)
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to parse attributes field: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 1, Context: "attributes", Value: p.Fields[1], Reason: "failed to parse attributes field", Err: err})
 	}
 	dataLength := int((attributes >> 8) & 0b1111) // bits 8-11
 	if dataLength*2 != (len(p.Fields[2])) {
-		p.SetErr("dlc", "data length does not match actual data length")
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 2, Context: "dlc", Value: p.Fields[2], Reason: "data length does not match actual data length"})
 	}
 	data, err := hex.DecodeString(p.Fields[2])
 	if err != nil {
-		p.err = fmt.Errorf("nmea: %s failed to decode data: %w", p.Prefix(), err)
-		return nil, p.Err()
+		p.setFieldErr(&FieldError{Index: 2, Context: "data", Value: p.Fields[2], Reason: "failed to decode data", Err: err})
 	}
 
 	return PGN{
@@ -64,3 +59,19 @@ func newPGN(s BaseSentence) (Sentence, error) {
 		Data:         data,
 	}, p.Err()
 }
+
+// EncodeFields returns the PGN sentence fields in wire format
+func (s PGN) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	if len(s.Data) > 0b1111 {
+		e.SetErr("dlc", "data length is too long")
+	}
+	attributes := int64(s.Address) | int64(len(s.Data))<<8 | int64(s.Priority&0b111)<<12
+	if s.IsSend {
+		attributes |= 1 << 15
+	}
+	e.HexInt64(int64(s.PGN), 6)
+	e.HexInt64(attributes, 4)
+	e.Hex(s.Data)
+	return e.Fields()
+}
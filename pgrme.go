@@ -22,7 +22,7 @@ type PGRME struct {
 
 // newPGRME constructor
 func newPGRME(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePGRME)
 
 	horizontal := p.Float64(0, "horizontal error")
@@ -41,3 +41,15 @@ func newPGRME(s BaseSentence) (Sentence, error) {
 		Spherical:    spherical,
 	}, p.Err()
 }
+
+// EncodeFields returns the PGRME sentence fields in wire format
+func (s PGRME) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Horizontal, 1)
+	e.Fixed(ErrorUnit)
+	e.Float64(s.Vertical, 1)
+	e.Fixed(ErrorUnit)
+	e.Float64(s.Spherical, 1)
+	e.Fixed(ErrorUnit)
+	return e.Fields()
+}
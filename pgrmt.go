@@ -35,7 +35,7 @@ type PGRMT struct {
 
 // newPGRMT constructor
 func newPGRMT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePGRMT)
 
 	return PGRMT{
@@ -51,3 +51,18 @@ func newPGRMT(s BaseSentence) (Sentence, error) {
 		SensorConfigurationData: p.EnumString(8, "sensor configuration data", DataRetainedPGRMT, DataLostPGRMT),
 	}, p.Err()
 }
+
+// EncodeFields returns the PGRMT sentence fields in wire format
+func (s PGRMT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.ModelAndFirmwareVersion)
+	e.String(s.ROMChecksumTest)
+	e.String(s.ReceiverFailureDiscrete)
+	e.String(s.StoredDataLost)
+	e.String(s.RealtimeClockLost)
+	e.String(s.OscillatorDriftDiscrete)
+	e.String(s.DataCollectionDiscrete)
+	e.Float64(s.SensorTemperature, 3)
+	e.String(s.SensorConfigurationData)
+	return e.Fields()
+}
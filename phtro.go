@@ -28,7 +28,7 @@ type PHTRO struct {
 
 // newPHTRO constructor
 func newPHTRO(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePHTRO)
 	m := PHTRO{
 		BaseSentence: s,
@@ -39,3 +39,13 @@ func newPHTRO(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the PHTRO sentence fields in wire format
+func (s PHTRO) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Pitch, 3)
+	e.String(s.Bow)
+	e.Float64(s.Roll, 3)
+	e.String(s.Port)
+	return e.Fields()
+}
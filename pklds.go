@@ -1,6 +1,7 @@
 package nmea
 
 import (
+	"math"
 	"strings"
 )
 
@@ -33,7 +34,7 @@ type PKLDS struct {
 
 // newPKLDS constructor
 func newPKLDS(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKLDS)
 	m := PKLDS{
 		BaseSentence:    s,
@@ -57,3 +58,43 @@ func newPKLDS(s BaseSentence) (Sentence, error) {
 	m.SentanceVersion = strings.TrimPrefix(strings.TrimPrefix(m.SentanceVersion, "W"), "E")
 	return m, p.Err()
 }
+
+// Position returns position of the fix
+func (s PKLDS) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the PKLDS sentence fields in wire format
+func (s PKLDS) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.Speed, 3)
+	e.Float64(s.Course, 3)
+	e.Date(s.Date, "date")
+	e.Float64(math.Abs(s.Variation), 3)
+	e.String(variationSentenceVersion(s.BaseSentence, 10, s.Variation, s.SentanceVersion))
+	e.String(s.Fleet)
+	e.String(s.UnitID)
+	e.String(s.Status)
+	e.String(s.Extension)
+	return e.Fields()
+}
+
+// variationSentenceVersion returns sentence version field with magnetic variation direction prefix. Original
+// prefix (or lack of it) is kept when it still matches the variation.
+func variationSentenceVersion(s BaseSentence, index int, variation float64, version string) string {
+	original := ""
+	if index < len(s.Fields) {
+		original = s.Fields[index]
+	}
+	switch {
+	case variation < 0 || (variation == 0 && strings.HasPrefix(original, "W")):
+		return "W" + version
+	case original != "" && !strings.HasPrefix(original, "E") && !strings.HasPrefix(original, "W"):
+		return version
+	}
+	return "E" + version
+}
@@ -20,7 +20,7 @@ type PKLID struct {
 
 // newPKLID constructor
 func newPKLID(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKLID)
 
 	return PKLID{
@@ -32,3 +32,14 @@ func newPKLID(s BaseSentence) (Sentence, error) {
 		Extension:       p.String(4, "reserved for future use, range of 00 to 99"),
 	}, p.Err()
 }
+
+// EncodeFields returns the PKLID sentence fields in wire format
+func (s PKLID) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.SentanceVersion)
+	e.String(s.Fleet)
+	e.String(s.UnitID)
+	e.String(s.Status)
+	e.String(s.Extension)
+	return e.Fields()
+}
@@ -24,7 +24,7 @@ type PKLSH struct {
 
 // newPKLSH constructor
 func newPKLSH(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKLSH)
 
 	return PKLSH{
@@ -37,3 +37,20 @@ func newPKLSH(s BaseSentence) (Sentence, error) {
 		UnitID:       p.String(7, "subscriber unit id, range of 1000 to 4999"),
 	}, p.Err()
 }
+
+// Position returns position of the fix
+func (s PKLSH) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the PKLSH sentence fields in wire format
+func (s PKLSH) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	e.String(s.Fleet)
+	e.String(s.UnitID)
+	return e.Fields()
+}
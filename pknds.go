@@ -1,6 +1,7 @@
 package nmea
 
 import (
+	"math"
 	"strings"
 )
 
@@ -32,7 +33,7 @@ type PKNDS struct {
 
 // newPKNDS constructor
 func newPKNDS(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKNDS)
 	m := PKNDS{
 		BaseSentence:    s,
@@ -55,3 +56,26 @@ func newPKNDS(s BaseSentence) (Sentence, error) {
 	m.SentanceVersion = strings.TrimPrefix(strings.TrimPrefix(m.SentanceVersion, "W"), "E")
 	return m, p.Err()
 }
+
+// Position returns position of the fix
+func (s PKNDS) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the PKNDS sentence fields in wire format
+func (s PKNDS) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.Speed, 3)
+	e.Float64(s.Course, 3)
+	e.Date(s.Date, "date")
+	e.Float64(math.Abs(s.Variation), 3)
+	e.String(variationSentenceVersion(s.BaseSentence, 10, s.Variation, s.SentanceVersion))
+	e.String(s.UnitID)
+	e.String(s.Status)
+	e.String(s.Extension)
+	return e.Fields()
+}
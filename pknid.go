@@ -19,7 +19,7 @@ type PKNID struct {
 
 // newPKNID constructor
 func newPKNID(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKNID)
 
 	return PKNID{
@@ -30,3 +30,13 @@ func newPKNID(s BaseSentence) (Sentence, error) {
 		Extension:       p.String(3, "reserved for future use, range of 00 to 99"),
 	}, p.Err()
 }
+
+// EncodeFields returns the PKNID sentence fields in wire format
+func (s PKNID) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.SentanceVersion)
+	e.String(s.UnitID)
+	e.String(s.Status)
+	e.String(s.Extension)
+	return e.Fields()
+}
@@ -23,7 +23,7 @@ type PKNSH struct {
 
 // newPKNSH constructor
 func newPKNSH(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKNSH)
 
 	return PKNSH{
@@ -35,3 +35,19 @@ func newPKNSH(s BaseSentence) (Sentence, error) {
 		UnitID:       p.String(6, "unit ID, NXDN range U00001 to U65519, DMR range of  U00000001 to U16776415"),
 	}, p.Err()
 }
+
+// Position returns position of the fix
+func (s PKNSH) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the PKNSH sentence fields in wire format
+func (s PKNSH) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	e.String(s.UnitID)
+	return e.Fields()
+}
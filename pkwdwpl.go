@@ -26,7 +26,7 @@ type PKWDWPL struct {
 
 // newPKWDWPL constructor
 func newPKWDWPL(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePKWDWPL)
 	m := PKWDWPL{
 		BaseSentence: s,
@@ -43,3 +43,24 @@ func newPKWDWPL(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// Position returns position of the waypoint
+func (s PKWDWPL) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the PKWDWPL sentence fields in wire format
+func (s PKWDWPL) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.Speed, 3)
+	e.Float64(s.Course, 3)
+	e.Date(s.Date, "date")
+	e.Float64(s.Altitude, 3)
+	e.String(s.WaypointName)
+	e.String(s.TableSymbol)
+	return e.Fields()
+}
@@ -30,7 +30,7 @@ type PMTK001 struct {
 
 // newPMTK001 constructor
 func newPMTK001(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 
 	cmd := p.Int64(0, "command")
 	flag := p.Int64(1, "flag")
@@ -40,3 +40,11 @@ func newPMTK001(s BaseSentence) (Sentence, error) {
 		Flag:         flag,
 	}, p.Err()
 }
+
+// EncodeFields returns the PMTK001 sentence fields in wire format
+func (s PMTK001) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.Cmd)
+	e.Int64(s.Flag)
+	return e.Fields()
+}
@@ -0,0 +1,327 @@
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// WGS84SemiMajorAxis is semi-major axis (equatorial radius) of WGS-84 ellipsoid in metres
+	WGS84SemiMajorAxis = 6378137.0
+	// WGS84Flattening is flattening of WGS-84 ellipsoid
+	WGS84Flattening = 1 / 298.257223563
+	// EarthMeanRadius is mean radius of the Earth in metres (IUGG), used for spherical calculations
+	EarthMeanRadius = 6371008.8
+
+	// vincentyMaxIterations is the maximum number of iterations of Vincenty formulas
+	vincentyMaxIterations = 1000
+	// vincentyPrecision is the change of iterated value (radians) when Vincenty formulas are considered converged
+	vincentyPrecision = 1e-12
+)
+
+// ErrVincentyNotConverged is returned when Vincenty inverse formula does not converge, which happens for nearly
+// antipodal points.
+var ErrVincentyNotConverged = errors.New("nmea: vincenty formula failed to converge")
+
+// Position is geographic position in decimal degrees. Latitude is positive to north and longitude is positive to east.
+type Position struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Geodesic is the shortest path between two positions on WGS-84 ellipsoid (great circle on a sphere)
+type Geodesic struct {
+	Distance       Distance // length of the path in metres
+	InitialBearing float64  // true bearing at start position in degrees (0 - 360)
+	FinalBearing   float64  // true bearing at end position in degrees (0 - 360)
+}
+
+// NewPosition returns position after checking that latitude and longitude are in range
+func NewPosition(latitude float64, longitude float64) (Position, error) {
+	p := Position{Latitude: latitude, Longitude: longitude}
+	if err := p.Validate(); err != nil {
+		return Position{}, err
+	}
+	return p, nil
+}
+
+// Validate checks that latitude is in range -90 to 90 and longitude is in range -180 to 180
+func (p Position) Validate() error {
+	if math.IsNaN(p.Latitude) || p.Latitude < -90 || p.Latitude > 90 {
+		return fmt.Errorf("nmea: latitude %v is out of range [-90, 90]", p.Latitude)
+	}
+	if math.IsNaN(p.Longitude) || p.Longitude < -180 || p.Longitude > 180 {
+		return fmt.Errorf("nmea: longitude %v is out of range [-180, 180]", p.Longitude)
+	}
+	return nil
+}
+
+// String returns position in degrees, minutes and seconds (e.g. 33° 8' 25.860000" N, 117° 9' 17.400000" W)
+func (p Position) String() string {
+	lat, lon := North, East
+	if p.Latitude < 0 {
+		lat = South
+	}
+	if p.Longitude < 0 {
+		lon = West
+	}
+	return fmt.Sprintf("%s %s, %s %s", FormatDMS(math.Abs(p.Latitude)), lat, FormatDMS(math.Abs(p.Longitude)), lon)
+}
+
+// Geodesic calculates distance and bearings of the shortest path to the position on WGS-84 ellipsoid with Vincenty
+// inverse formula. Returns ErrVincentyNotConverged for nearly antipodal positions.
+func (p Position) Geodesic(to Position) (Geodesic, error) {
+	a := WGS84SemiMajorAxis
+	f := WGS84Flattening
+	b := (1 - f) * a
+
+	L := toRadians(to.Longitude - p.Longitude)
+	tanU1 := (1 - f) * math.Tan(toRadians(p.Latitude))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	tanU2 := (1 - f) * math.Tan(toRadians(to.Latitude))
+	cosU2 := 1 / math.Sqrt(1+tanU2*tanU2)
+	sinU2 := tanU2 * cosU2
+
+	var sinLambda, cosLambda, sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+	lambda := L
+	converged := false
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		x := cosU1*sinU2 - sinU1*cosU2*cosLambda
+		sinSqSigma := cosU2*sinLambda*cosU2*sinLambda + x*x
+		if sinSqSigma < 1e-24 {
+			// coincident positions
+			return Geodesic{Distance: Distance{Value: 0, Unit: DistanceUnitMetre}}, nil
+		}
+		sinSigma = math.Sqrt(sinSqSigma)
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0 // equatorial line
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda) > math.Pi {
+			break
+		}
+		if math.Abs(lambda-previous) < vincentyPrecision {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return Geodesic{}, ErrVincentyNotConverged
+	}
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A, B := vincentyAB(uSq)
+	deltaSigma := vincentyDeltaSigma(B, sinSigma, cosSigma, cos2SigmaM)
+	s := b * A * (sigma - deltaSigma)
+
+	alpha1 := math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+	alpha2 := math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)
+	return Geodesic{
+		Distance:       Distance{Value: s, Unit: DistanceUnitMetre},
+		InitialBearing: normalizeBearing(toDegrees(alpha1)),
+		FinalBearing:   normalizeBearing(toDegrees(alpha2)),
+	}, nil
+}
+
+// Distance returns distance to the position on WGS-84 ellipsoid (see Geodesic)
+func (p Position) Distance(to Position) (Distance, error) {
+	g, err := p.Geodesic(to)
+	return g.Distance, err
+}
+
+// InitialBearing returns true bearing in degrees at the start of the shortest path to the position (see Geodesic)
+func (p Position) InitialBearing(to Position) (float64, error) {
+	g, err := p.Geodesic(to)
+	return g.InitialBearing, err
+}
+
+// FinalBearing returns true bearing in degrees at the end of the shortest path to the position (see Geodesic)
+func (p Position) FinalBearing(to Position) (float64, error) {
+	g, err := p.Geodesic(to)
+	return g.FinalBearing, err
+}
+
+// Destination returns position at distance along the shortest path starting with true bearing (degrees) on WGS-84
+// ellipsoid with Vincenty direct formula.
+func (p Position) Destination(bearing float64, distance Distance) (Position, error) {
+	s := distance.Meters()
+	if math.IsNaN(s) {
+		return Position{}, fmt.Errorf("nmea: unknown distance unit %q", distance.Unit)
+	}
+	a := WGS84SemiMajorAxis
+	f := WGS84Flattening
+	b := (1 - f) * a
+
+	sinAlpha1, cosAlpha1 := math.Sincos(toRadians(bearing))
+	tanU1 := (1 - f) * math.Tan(toRadians(p.Latitude))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	sigma1 := math.Atan2(tanU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A, B := vincentyAB(uSq)
+
+	sigma := s / (b * A)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	for i := 0; i < vincentyMaxIterations; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		previous := sigma
+		sigma = s/(b*A) + vincentyDeltaSigma(B, sinSigma, cosSigma, cos2SigmaM)
+		if math.Abs(sigma-previous) < vincentyPrecision {
+			break
+		}
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	lat := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Sqrt(sinAlpha*sinAlpha+x*x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+	L := lambda - (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	return Position{
+		Latitude:  toDegrees(lat),
+		Longitude: normalizeLongitude(p.Longitude + toDegrees(L)),
+	}, nil
+}
+
+// RhumbBearing returns constant true bearing in degrees of the rhumb line (loxodrome) to the position on WGS-84
+// ellipsoid
+func (p Position) RhumbBearing(to Position) float64 {
+	deltaPsi := isometricLatitude(toRadians(to.Latitude)) - isometricLatitude(toRadians(p.Latitude))
+	deltaLambda := toRadians(normalizeLongitude(to.Longitude - p.Longitude))
+	return normalizeBearing(toDegrees(math.Atan2(deltaLambda, deltaPsi)))
+}
+
+// RhumbDistance returns distance along the rhumb line (loxodrome) to the position on WGS-84 ellipsoid
+func (p Position) RhumbDistance(to Position) Distance {
+	lat1 := toRadians(p.Latitude)
+	lat2 := toRadians(to.Latitude)
+	deltaPsi := isometricLatitude(lat2) - isometricLatitude(lat1)
+	deltaLambda := toRadians(normalizeLongitude(to.Longitude - p.Longitude))
+
+	var s float64
+	if math.Abs(deltaPsi) < 1e-12 {
+		// east-west line along the parallel
+		e2 := WGS84Flattening * (2 - WGS84Flattening)
+		sinLat := math.Sin(lat1)
+		s = math.Abs(deltaLambda) * WGS84SemiMajorAxis * math.Cos(lat1) / math.Sqrt(1-e2*sinLat*sinLat)
+	} else {
+		bearing := math.Atan2(deltaLambda, deltaPsi)
+		s = math.Abs((meridianArc(lat2) - meridianArc(lat1)) / math.Cos(bearing))
+	}
+	return Distance{Value: s, Unit: DistanceUnitMetre}
+}
+
+// CrossTrackDistance returns distance from the position to the great circle path from start to end. Distance is
+// positive when the position is right of the path and negative when left of the path. Calculation is done on a
+// sphere with EarthMeanRadius.
+func (p Position) CrossTrackDistance(start Position, end Position) Distance {
+	delta13 := sphericalAngularDistance(start, p)
+	theta13 := sphericalBearing(start, p)
+	theta12 := sphericalBearing(start, end)
+	dxt := math.Asin(math.Sin(delta13) * math.Sin(theta13-theta12))
+	return Distance{Value: dxt * EarthMeanRadius, Unit: DistanceUnitMetre}
+}
+
+// AlongTrackDistance returns distance from start to the point on the great circle path from start to end closest to
+// the position. Distance is negative when closest point is behind the start. Calculation is done on a sphere with
+// EarthMeanRadius.
+func (p Position) AlongTrackDistance(start Position, end Position) Distance {
+	delta13 := sphericalAngularDistance(start, p)
+	theta13 := sphericalBearing(start, p)
+	theta12 := sphericalBearing(start, end)
+	dxt := math.Asin(math.Sin(delta13) * math.Sin(theta13-theta12))
+	cosRatio := math.Max(-1, math.Min(1, math.Cos(delta13)/math.Cos(dxt)))
+	dat := math.Acos(cosRatio)
+	if math.Cos(theta12-theta13) < 0 {
+		dat = -dat
+	}
+	return Distance{Value: dat * EarthMeanRadius, Unit: DistanceUnitMetre}
+}
+
+func vincentyAB(uSq float64) (float64, float64) {
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	return A, B
+}
+
+func vincentyDeltaSigma(B, sinSigma, cosSigma, cos2SigmaM float64) float64 {
+	return B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+}
+
+// isometricLatitude returns isometric latitude (Mercator projection ordinate) on WGS-84 ellipsoid
+func isometricLatitude(lat float64) float64 {
+	e := math.Sqrt(WGS84Flattening * (2 - WGS84Flattening))
+	sinLat := math.Sin(lat)
+	return math.Atanh(sinLat) - e*math.Atanh(e*sinLat)
+}
+
+// meridianArc returns distance along the meridian from the equator to the latitude on WGS-84 ellipsoid
+func meridianArc(lat float64) float64 {
+	e2 := WGS84Flattening * (2 - WGS84Flattening)
+	e4 := e2 * e2
+	e6 := e4 * e2
+	return WGS84SemiMajorAxis * ((1-e2/4-3*e4/64-5*e6/256)*lat -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*lat) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*lat) -
+		(35*e6/3072)*math.Sin(6*lat))
+}
+
+// sphericalAngularDistance returns angular distance (radians) between positions with haversine formula
+func sphericalAngularDistance(from Position, to Position) float64 {
+	lat1, lat2 := toRadians(from.Latitude), toRadians(to.Latitude)
+	sinDLat := math.Sin((lat2 - lat1) / 2)
+	sinDLon := math.Sin(toRadians(to.Longitude-from.Longitude) / 2)
+	h := sinDLat*sinDLat + math.Cos(lat1)*math.Cos(lat2)*sinDLon*sinDLon
+	return 2 * math.Atan2(math.Sqrt(h), math.Sqrt(1-h))
+}
+
+// sphericalBearing returns initial bearing (radians) of the great circle between positions on a sphere
+func sphericalBearing(from Position, to Position) float64 {
+	lat1, lat2 := toRadians(from.Latitude), toRadians(to.Latitude)
+	dLon := toRadians(to.Longitude - from.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Atan2(y, x)
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// normalizeBearing returns bearing in range 0 - 360 degrees
+func normalizeBearing(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
+
+// normalizeLongitude returns longitude in range -180 - 180 degrees
+func normalizeLongitude(degrees float64) float64 {
+	degrees = math.Mod(degrees+180, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees - 180
+}
@@ -0,0 +1,153 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// Vincenty (1975) test line: Flinders Peak to Buninyong
+	flindersPeak = Position{Latitude: -(37 + 57/60.0 + 3.72030/3600), Longitude: 144 + 25/60.0 + 29.52440/3600}
+	buninyong    = Position{Latitude: -(37 + 39/60.0 + 10.15610/3600), Longitude: 143 + 55/60.0 + 35.38390/3600}
+	plymouth     = Position{Latitude: 50 + 21/60.0 + 59/3600.0, Longitude: -(4 + 8/60.0 + 2/3600.0)}
+	boston       = Position{Latitude: 42 + 21/60.0 + 4/3600.0, Longitude: -(71 + 2/60.0 + 27/3600.0)}
+)
+
+func TestNewPosition(t *testing.T) {
+	var testCases = []struct {
+		name      string
+		lat       float64
+		lon       float64
+		expectErr string
+	}{
+		{name: "ok", lat: -37.5, lon: 144.5},
+		{name: "poles and date line", lat: 90, lon: -180},
+		{name: "latitude out of range", lat: 90.1, lon: 0, expectErr: "nmea: latitude 90.1 is out of range [-90, 90]"},
+		{name: "longitude out of range", lat: 0, lon: -180.5, expectErr: "nmea: longitude -180.5 is out of range [-180, 180]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewPosition(tc.lat, tc.lon)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, Position{Latitude: tc.lat, Longitude: tc.lon}, p)
+		})
+	}
+}
+
+func TestPosition_String(t *testing.T) {
+	p := Position{Latitude: -33.5, Longitude: 151.25}
+	assert.Equal(t, "33° 30' 0.000000\" S, 151° 15' 0.000000\" E", p.String())
+}
+
+func TestPosition_Geodesic(t *testing.T) {
+	g, err := flindersPeak.Geodesic(buninyong)
+	assert.NoError(t, err)
+	assert.Equal(t, DistanceUnitMetre, g.Distance.Unit)
+	assert.InDelta(t, 54972.271, g.Distance.Value, 0.001)
+	assert.InDelta(t, 306+52/60.0+5.37/3600, g.InitialBearing, 0.00001)
+	assert.InDelta(t, 127+10/60.0+25.07/3600+180, g.FinalBearing, 0.00001)
+
+	d, err := buninyong.Distance(flindersPeak)
+	assert.NoError(t, err)
+	assert.InDelta(t, 54972.271, d.Value, 0.001)
+
+	initial, err := plymouth.InitialBearing(boston)
+	assert.NoError(t, err)
+	final, err := plymouth.FinalBearing(boston)
+	assert.NoError(t, err)
+	// close to great circle bearings on a sphere (286.895 and 235.677)
+	assert.InDelta(t, 286.9, initial, 0.1)
+	assert.InDelta(t, 235.7, final, 0.1)
+
+	same, err := boston.Geodesic(boston)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, same.Distance.Value)
+
+	// equator, quarter of the circumference
+	d, err = Position{}.Distance(Position{Longitude: 90})
+	assert.NoError(t, err)
+	assert.InDelta(t, 10018754.171, d.Value, 0.001)
+
+	_, err = Position{Latitude: 0, Longitude: 0}.Geodesic(Position{Latitude: 0.5, Longitude: 179.7})
+	assert.Equal(t, ErrVincentyNotConverged, err)
+}
+
+func TestPosition_Destination(t *testing.T) {
+	p, err := flindersPeak.Destination(306+52/60.0+5.37/3600, Distance{Value: 54972.271, Unit: DistanceUnitMetre})
+	assert.NoError(t, err)
+	assert.InDelta(t, buninyong.Latitude, p.Latitude, 0.0000001)
+	assert.InDelta(t, buninyong.Longitude, p.Longitude, 0.0000001)
+
+	// across the date line
+	p, err = Position{Latitude: 0, Longitude: 179.5}.Destination(90, Distance{Value: 60, Unit: DistanceUnitNauticalMile})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, p.Latitude, 0.0000001)
+	assert.InDelta(t, -179.501792, p.Longitude, 0.000001)
+
+	_, err = flindersPeak.Destination(0, Distance{Value: 1})
+	assert.EqualError(t, err, `nmea: unknown distance unit ""`)
+}
+
+func TestPosition_Rhumb(t *testing.T) {
+	assert.InDelta(t, 260.158049, plymouth.RhumbBearing(boston), 0.000001)
+	assert.InDelta(t, 5212422.073, plymouth.RhumbDistance(boston).Value, 0.01)
+
+	// rhumb line along meridian is geodesic
+	geodesic, err := flindersPeak.Distance(Position{Latitude: 10, Longitude: flindersPeak.Longitude})
+	assert.NoError(t, err)
+	assert.InDelta(t, geodesic.Value, flindersPeak.RhumbDistance(Position{Latitude: 10, Longitude: flindersPeak.Longitude}).Value, 0.001)
+	assert.Equal(t, 0.0, flindersPeak.RhumbBearing(Position{Latitude: 10, Longitude: flindersPeak.Longitude}))
+
+	// rhumb line along parallel, shorter way across the date line
+	west := Position{Latitude: 60, Longitude: 179}
+	east := Position{Latitude: 60, Longitude: -179}
+	assert.Equal(t, 90.0, west.RhumbBearing(east))
+	assert.InDelta(t, 111.6000, west.RhumbDistance(east).Kilometers(), 0.0001)
+}
+
+func TestPosition_CrossTrack(t *testing.T) {
+	p := Position{Latitude: 53.2611, Longitude: -0.7972}
+	start := Position{Latitude: 53.3206, Longitude: -1.7297}
+	end := Position{Latitude: 53.1887, Longitude: 0.1334}
+
+	assert.InDelta(t, -307.55, p.CrossTrackDistance(start, end).Value, 0.01)
+	assert.InDelta(t, 62.3315, p.AlongTrackDistance(start, end).Kilometers(), 0.0001)
+	assert.InDelta(t, 307.55, p.CrossTrackDistance(end, start).Value, 0.01)
+
+	behind := Position{Latitude: 53.4, Longitude: -2.5}
+	assert.True(t, behind.AlongTrackDistance(start, end).Value < 0)
+}
+
+func TestSentencePositions(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		when   func() Position
+		expect Position
+	}{
+		{name: "GGA", when: GGA{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "RMC", when: RMC{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "GLL", when: GLL{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "GNS", when: GNS{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "WPL", when: WPL{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "PKWDWPL", when: PKWDWPL{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "PKLDS", when: PKLDS{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "PKLSH", when: PKLSH{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "PKNDS", when: PKNDS{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "PKNSH", when: PKNSH{Latitude: 1, Longitude: 2}.Position, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "BEC", when: BEC{Latitude: 1, Longitude: 2}.WaypointPosition, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "BWC", when: BWC{Latitude: 1, Longitude: 2}.WaypointPosition, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "BWR", when: BWR{Latitude: 1, Longitude: 2}.WaypointPosition, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "RMB", when: RMB{DestinationLatitude: 1, DestinationLongitude: 2}.DestinationPosition, expect: Position{Latitude: 1, Longitude: 2}},
+		{name: "TLL", when: TLL{TargetLatitude: 1, TargetLongitude: 2}.TargetPosition, expect: Position{Latitude: 1, Longitude: 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.when())
+		})
+	}
+}
@@ -19,7 +19,7 @@ type PRDID struct {
 
 // newPRDID constructor
 func newPRDID(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePRDID)
 	m := PRDID{
 		BaseSentence: s,
@@ -29,3 +29,12 @@ func newPRDID(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the PRDID sentence fields in wire format
+func (s PRDID) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Pitch, 3)
+	e.Float64(s.Roll, 3)
+	e.Float64(s.Heading, 3)
+	return e.Fields()
+}
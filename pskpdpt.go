@@ -30,7 +30,7 @@ type PSKPDPT struct {
 
 // newPSKPDPT constructor
 func newPSKPDPT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePSKPDPT)
 	sentence := PSKPDPT{
 		BaseSentence:       s,
@@ -43,3 +43,15 @@ func newPSKPDPT(s BaseSentence) (Sentence, error) {
 	}
 	return sentence, p.Err()
 }
+
+// EncodeFields returns the PSKPDPT sentence fields in wire format
+func (s PSKPDPT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Depth, 3)
+	e.Float64(s.Offset, 3)
+	e.Float64(s.RangeScale, 3)
+	e.Int64(s.BottomEchoStrength)
+	e.Int64(s.ChannelNumber)
+	e.String(s.TransducerLocation)
+	return e.Fields()
+}
@@ -32,7 +32,7 @@ type PSONCMS struct {
 
 // newPSONCMS constructor
 func newPSONCMS(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypePSONCMS)
 	m := PSONCMS{
 		BaseSentence:      s,
@@ -53,3 +53,23 @@ func newPSONCMS(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the PSONCMS sentence fields in wire format
+func (s PSONCMS) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Quaternion0, 4)
+	e.Float64(s.Quaternion1, 4)
+	e.Float64(s.Quaternion2, 4)
+	e.Float64(s.Quaternion3, 4)
+	e.Float64(s.AccelerationX, 4)
+	e.Float64(s.AccelerationY, 4)
+	e.Float64(s.AccelerationZ, 4)
+	e.Float64(s.RateOfTurnX, 4)
+	e.Float64(s.RateOfTurnY, 4)
+	e.Float64(s.RateOfTurnZ, 4)
+	e.Float64(s.MagneticFieldX, 4)
+	e.Float64(s.MagneticFieldY, 4)
+	e.Float64(s.MagneticFieldZ, 4)
+	e.Float64(s.SensorTemperature, 2)
+	return e.Fields()
+}
@@ -0,0 +1,315 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// Speed is speed value with its unit (SpeedKnots, SpeedMeterPerSecond, SpeedKilometerPerHour or
+// SpeedStatuteMilePerHour)
+type Speed struct {
+	Value float64
+	Unit  string
+}
+
+// Distance is distance value with its unit (DistanceUnitMetre, DistanceUnitKilometre, DistanceUnitNauticalMile,
+// DistanceUnitStatuteMile, DistanceUnitFeet or DistanceUnitFathom)
+type Distance struct {
+	Value float64
+	Unit  string
+}
+
+// Depth is water depth with its unit (DistanceUnitMetre, DistanceUnitFeet or DistanceUnitFathom)
+type Depth struct {
+	Value float64
+	Unit  string
+}
+
+// Temperature is temperature value with its unit (UnitCelsius, UnitFahrenheit or UnitKelvin)
+type Temperature struct {
+	Value float64
+	Unit  string
+}
+
+// Pressure is pressure value with its unit (UnitBars, UnitPascal or UnitInchesOfMercury)
+type Pressure struct {
+	Value float64
+	Unit  string
+}
+
+// Angle is angle value with its unit (UnitDegrees)
+type Angle struct {
+	Value float64
+	Unit  string
+}
+
+// speedFactors contains speed units in metres per second
+var speedFactors = map[string]float64{
+	SpeedMeterPerSecond:     1,
+	SpeedKnots:              1852.0 / 3600,
+	SpeedKilometerPerHour:   1000.0 / 3600,
+	SpeedStatuteMilePerHour: 1609.344 / 3600,
+}
+
+// distanceFactors contains distance units in metres
+var distanceFactors = map[string]float64{
+	DistanceUnitMetre:        1,
+	DistanceUnitKilometre:    1000,
+	DistanceUnitNauticalMile: 1852,
+	DistanceUnitStatuteMile:  1609.344,
+	DistanceUnitFeet:         0.3048,
+	DistanceUnitFathom:       1.8288,
+}
+
+// pressureFactors contains pressure units in pascals
+var pressureFactors = map[string]float64{
+	UnitPascal:          1,
+	UnitBars:            100000,
+	UnitInchesOfMercury: 3386.389,
+}
+
+// angleFactors contains angle units in degrees
+var angleFactors = map[string]float64{
+	UnitDegrees: 1,
+}
+
+// convertUnit converts value between units of the same quantity. Returns NaN when either of units is unknown.
+func convertUnit(value float64, from string, to string, factors map[string]float64) float64 {
+	fromFactor, ok := factors[from]
+	if !ok {
+		return math.NaN()
+	}
+	toFactor, ok := factors[to]
+	if !ok {
+		return math.NaN()
+	}
+	if from == to {
+		return value
+	}
+	return value * fromFactor / toFactor
+}
+
+// quantityTo converts value to unit and returns error when conversion is not possible
+func quantityTo(quantity string, value float64, from string, to string, factors map[string]float64) (float64, error) {
+	v := convertUnit(value, from, to, factors)
+	if math.IsNaN(v) {
+		return 0, fmt.Errorf("nmea: can not convert %s from unit %q to %q", quantity, from, to)
+	}
+	return v, nil
+}
+
+// depthOf returns depth from DBK, DBS or DBT sentence that has depth in feet, metres and fathoms at fields 0, 2 and
+// 4. Depth in metres is preferred. Returns false when none of the depths is present.
+func depthOf(s BaseSentence, feet, meters, fathoms float64) (Depth, bool) {
+	switch {
+	case valuePresent(s, 2, meters):
+		return Depth{Value: meters, Unit: DistanceUnitMetre}, true
+	case valuePresent(s, 0, feet):
+		return Depth{Value: feet, Unit: DistanceUnitFeet}, true
+	case valuePresent(s, 4, fathoms):
+		return Depth{Value: fathoms, Unit: DistanceUnitFathom}, true
+	}
+	return Depth{}, false
+}
+
+// valuePresent reports whether value of the field is present: field is not empty in parsed sentence or value is not
+// zero in sentence without fields.
+func valuePresent(s BaseSentence, i int, v float64) bool {
+	if len(s.Fields) > 0 {
+		return i < len(s.Fields) && s.Fields[i] != ""
+	}
+	return v != 0
+}
+
+// windSpeedOf returns wind speed from sentence that has speed in knots and metres per second
+func windSpeedOf(knots float64, knotsValid bool, meters float64, metersValid bool) (Speed, bool) {
+	if knotsValid {
+		return Speed{Value: knots, Unit: SpeedKnots}, true
+	}
+	if metersValid {
+		return Speed{Value: meters, Unit: SpeedMeterPerSecond}, true
+	}
+	return Speed{}, false
+}
+
+// To converts speed to the unit
+func (s Speed) To(unit string) (Speed, error) {
+	v, err := quantityTo("speed", s.Value, s.Unit, unit, speedFactors)
+	return Speed{Value: v, Unit: unit}, err
+}
+
+// Knots returns speed in knots. Returns NaN when unit is unknown.
+func (s Speed) Knots() float64 {
+	return convertUnit(s.Value, s.Unit, SpeedKnots, speedFactors)
+}
+
+// MetersPerSecond returns speed in metres per second. Returns NaN when unit is unknown.
+func (s Speed) MetersPerSecond() float64 {
+	return convertUnit(s.Value, s.Unit, SpeedMeterPerSecond, speedFactors)
+}
+
+// KilometersPerHour returns speed in kilometres per hour. Returns NaN when unit is unknown.
+func (s Speed) KilometersPerHour() float64 {
+	return convertUnit(s.Value, s.Unit, SpeedKilometerPerHour, speedFactors)
+}
+
+// To converts distance to the unit
+func (d Distance) To(unit string) (Distance, error) {
+	v, err := quantityTo("distance", d.Value, d.Unit, unit, distanceFactors)
+	return Distance{Value: v, Unit: unit}, err
+}
+
+// Meters returns distance in metres. Returns NaN when unit is unknown.
+func (d Distance) Meters() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitMetre, distanceFactors)
+}
+
+// Kilometers returns distance in kilometres. Returns NaN when unit is unknown.
+func (d Distance) Kilometers() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitKilometre, distanceFactors)
+}
+
+// NauticalMiles returns distance in nautical miles. Returns NaN when unit is unknown.
+func (d Distance) NauticalMiles() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitNauticalMile, distanceFactors)
+}
+
+// StatuteMiles returns distance in statute miles. Returns NaN when unit is unknown.
+func (d Distance) StatuteMiles() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitStatuteMile, distanceFactors)
+}
+
+// Feet returns distance in feet. Returns NaN when unit is unknown.
+func (d Distance) Feet() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitFeet, distanceFactors)
+}
+
+// Fathoms returns distance in fathoms. Returns NaN when unit is unknown.
+func (d Distance) Fathoms() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitFathom, distanceFactors)
+}
+
+// To converts depth to the unit
+func (d Depth) To(unit string) (Depth, error) {
+	v, err := quantityTo("depth", d.Value, d.Unit, unit, distanceFactors)
+	return Depth{Value: v, Unit: unit}, err
+}
+
+// Distance returns depth as distance
+func (d Depth) Distance() Distance {
+	return Distance{Value: d.Value, Unit: d.Unit}
+}
+
+// Meters returns depth in metres. Returns NaN when unit is unknown.
+func (d Depth) Meters() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitMetre, distanceFactors)
+}
+
+// Feet returns depth in feet. Returns NaN when unit is unknown.
+func (d Depth) Feet() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitFeet, distanceFactors)
+}
+
+// Fathoms returns depth in fathoms. Returns NaN when unit is unknown.
+func (d Depth) Fathoms() float64 {
+	return convertUnit(d.Value, d.Unit, DistanceUnitFathom, distanceFactors)
+}
+
+// To converts temperature to the unit
+func (t Temperature) To(unit string) (Temperature, error) {
+	v := convertTemperature(t.Value, t.Unit, unit)
+	if math.IsNaN(v) {
+		return Temperature{Unit: unit}, fmt.Errorf("nmea: can not convert temperature from unit %q to %q", t.Unit, unit)
+	}
+	return Temperature{Value: v, Unit: unit}, nil
+}
+
+// Celsius returns temperature in degrees Celsius. Returns NaN when unit is unknown.
+func (t Temperature) Celsius() float64 {
+	return convertTemperature(t.Value, t.Unit, UnitCelsius)
+}
+
+// Fahrenheit returns temperature in degrees Fahrenheit. Returns NaN when unit is unknown.
+func (t Temperature) Fahrenheit() float64 {
+	return convertTemperature(t.Value, t.Unit, UnitFahrenheit)
+}
+
+// Kelvin returns temperature in kelvins. Returns NaN when unit is unknown.
+func (t Temperature) Kelvin() float64 {
+	return convertTemperature(t.Value, t.Unit, UnitKelvin)
+}
+
+// convertTemperature converts temperature between units. Returns NaN when either of units is unknown.
+func convertTemperature(value float64, from string, to string) float64 {
+	kelvin := temperatureToKelvin(value, from)
+	if from == to && !math.IsNaN(kelvin) {
+		return value
+	}
+	return temperatureFromKelvin(kelvin, to)
+}
+
+func temperatureToKelvin(value float64, unit string) float64 {
+	switch unit {
+	case UnitKelvin:
+		return value
+	case UnitCelsius:
+		return value + 273.15
+	case UnitFahrenheit:
+		return (value-32)/1.8 + 273.15
+	}
+	return math.NaN()
+}
+
+func temperatureFromKelvin(kelvin float64, unit string) float64 {
+	switch unit {
+	case UnitKelvin:
+		return kelvin
+	case UnitCelsius:
+		return kelvin - 273.15
+	case UnitFahrenheit:
+		return (kelvin-273.15)*1.8 + 32
+	}
+	return math.NaN()
+}
+
+// To converts pressure to the unit
+func (p Pressure) To(unit string) (Pressure, error) {
+	v, err := quantityTo("pressure", p.Value, p.Unit, unit, pressureFactors)
+	return Pressure{Value: v, Unit: unit}, err
+}
+
+// Pascals returns pressure in pascals. Returns NaN when unit is unknown.
+func (p Pressure) Pascals() float64 {
+	return convertUnit(p.Value, p.Unit, UnitPascal, pressureFactors)
+}
+
+// Hectopascals returns pressure in hectopascals (millibars). Returns NaN when unit is unknown.
+func (p Pressure) Hectopascals() float64 {
+	return p.Pascals() / 100
+}
+
+// Bars returns pressure in bars. Returns NaN when unit is unknown.
+func (p Pressure) Bars() float64 {
+	return convertUnit(p.Value, p.Unit, UnitBars, pressureFactors)
+}
+
+// InchesOfMercury returns pressure in inches of mercury. Returns NaN when unit is unknown.
+func (p Pressure) InchesOfMercury() float64 {
+	return convertUnit(p.Value, p.Unit, UnitInchesOfMercury, pressureFactors)
+}
+
+// To converts angle to the unit
+func (a Angle) To(unit string) (Angle, error) {
+	v, err := quantityTo("angle", a.Value, a.Unit, unit, angleFactors)
+	return Angle{Value: v, Unit: unit}, err
+}
+
+// Degrees returns angle in degrees. Returns NaN when unit is unknown.
+func (a Angle) Degrees() float64 {
+	return convertUnit(a.Value, a.Unit, UnitDegrees, angleFactors)
+}
+
+// Radians returns angle in radians. Returns NaN when unit is unknown.
+func (a Angle) Radians() float64 {
+	return a.Degrees() * math.Pi / 180
+}
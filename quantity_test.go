@@ -0,0 +1,120 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpeed(t *testing.T) {
+	s := Speed{Value: 10, Unit: SpeedKnots}
+	assert.Equal(t, 10.0, s.Knots())
+	assert.InDelta(t, 5.144444, s.MetersPerSecond(), 0.000001)
+	assert.InDelta(t, 18.52, s.KilometersPerHour(), 0.000001)
+
+	mph, err := s.To(SpeedStatuteMilePerHour)
+	assert.NoError(t, err)
+	assert.Equal(t, SpeedStatuteMilePerHour, mph.Unit)
+	assert.InDelta(t, 11.507794, mph.Value, 0.000001)
+
+	_, err = s.To("X")
+	assert.EqualError(t, err, `nmea: can not convert speed from unit "N" to "X"`)
+	assert.True(t, math.IsNaN(Speed{Value: 1}.Knots()))
+}
+
+func TestDistance(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		when   Distance
+		unit   string
+		expect float64
+	}{
+		{name: "nautical miles to metres", when: Distance{Value: 1.5, Unit: DistanceUnitNauticalMile}, unit: DistanceUnitMetre, expect: 2778},
+		{name: "metres to kilometres", when: Distance{Value: 1500, Unit: DistanceUnitMetre}, unit: DistanceUnitKilometre, expect: 1.5},
+		{name: "statute miles to feet", when: Distance{Value: 1, Unit: DistanceUnitStatuteMile}, unit: DistanceUnitFeet, expect: 5280},
+		{name: "fathoms to feet", when: Distance{Value: 2, Unit: DistanceUnitFathom}, unit: DistanceUnitFeet, expect: 12},
+		{name: "same unit", when: Distance{Value: 0.1, Unit: DistanceUnitFeet}, unit: DistanceUnitFeet, expect: 0.1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := tc.when.To(tc.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.unit, d.Unit)
+			assert.InDelta(t, tc.expect, d.Value, 0.000001)
+		})
+	}
+
+	d := Distance{Value: 1852, Unit: DistanceUnitMetre}
+	assert.Equal(t, 1.0, d.NauticalMiles())
+	assert.Equal(t, 1.852, d.Kilometers())
+	assert.InDelta(t, 1.150779, d.StatuteMiles(), 0.000001)
+	assert.InDelta(t, 6076.115486, d.Feet(), 0.000001)
+	assert.InDelta(t, 1012.685914, d.Fathoms(), 0.000001)
+	assert.Equal(t, 1852.0, d.Meters())
+}
+
+func TestDepth(t *testing.T) {
+	d := Depth{Value: 10, Unit: DistanceUnitFeet}
+	assert.InDelta(t, 3.048, d.Meters(), 0.000001)
+	assert.InDelta(t, 1.666667, d.Fathoms(), 0.000001)
+	assert.Equal(t, 10.0, d.Feet())
+	assert.Equal(t, Distance{Value: 10, Unit: DistanceUnitFeet}, d.Distance())
+
+	m, err := d.To(DistanceUnitMetre)
+	assert.NoError(t, err)
+	assert.InDelta(t, 3.048, m.Value, 0.000001)
+
+	_, err = Depth{Value: 1, Unit: "x"}.To(DistanceUnitMetre)
+	assert.EqualError(t, err, `nmea: can not convert depth from unit "x" to "M"`)
+}
+
+func TestTemperature(t *testing.T) {
+	var testCases = []struct {
+		name             string
+		when             Temperature
+		expectCelsius    float64
+		expectFahrenheit float64
+		expectKelvin     float64
+	}{
+		{name: "celsius", when: Temperature{Value: 100, Unit: UnitCelsius}, expectCelsius: 100, expectFahrenheit: 212, expectKelvin: 373.15},
+		{name: "fahrenheit", when: Temperature{Value: -40, Unit: UnitFahrenheit}, expectCelsius: -40, expectFahrenheit: -40, expectKelvin: 233.15},
+		{name: "kelvin", when: Temperature{Value: 0, Unit: UnitKelvin}, expectCelsius: -273.15, expectFahrenheit: -459.67, expectKelvin: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expectCelsius, tc.when.Celsius(), 0.000001)
+			assert.InDelta(t, tc.expectFahrenheit, tc.when.Fahrenheit(), 0.000001)
+			assert.InDelta(t, tc.expectKelvin, tc.when.Kelvin(), 0.000001)
+		})
+	}
+
+	same, err := Temperature{Value: 17.9, Unit: UnitCelsius}.To(UnitCelsius)
+	assert.NoError(t, err)
+	assert.Equal(t, Temperature{Value: 17.9, Unit: UnitCelsius}, same)
+
+	_, err = Temperature{Value: 17.9, Unit: UnitCelsius}.To("X")
+	assert.EqualError(t, err, `nmea: can not convert temperature from unit "C" to "X"`)
+	assert.True(t, math.IsNaN(Temperature{Value: 1, Unit: ""}.Celsius()))
+}
+
+func TestPressure(t *testing.T) {
+	p := Pressure{Value: 1.01325, Unit: UnitBars}
+	assert.InDelta(t, 101325, p.Pascals(), 0.000001)
+	assert.InDelta(t, 1013.25, p.Hectopascals(), 0.000001)
+	assert.InDelta(t, 29.921252, p.InchesOfMercury(), 0.000001)
+	assert.Equal(t, 1.01325, p.Bars())
+
+	inHg, err := Pressure{Value: 29.92, Unit: UnitInchesOfMercury}.To(UnitBars)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.013208, inHg.Value, 0.000001)
+}
+
+func TestAngle(t *testing.T) {
+	a := Angle{Value: 180, Unit: UnitDegrees}
+	assert.Equal(t, 180.0, a.Degrees())
+	assert.Equal(t, math.Pi, a.Radians())
+
+	_, err := a.To("R")
+	assert.EqualError(t, err, `nmea: can not convert angle from unit "D" to "R"`)
+}
@@ -1,5 +1,10 @@
 package nmea
 
+import (
+	"fmt"
+	"sync"
+)
+
 const (
 	// TypeQuery type of Query sentence for a listener to request a particular sentence from a talker
 	TypeQuery = "Q"
@@ -18,7 +23,7 @@ type Query struct {
 
 // newQuery constructor
 func newQuery(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeQuery)
 
 	return Query{
@@ -27,3 +32,100 @@ func newQuery(s BaseSentence) (Sentence, error) {
 		RequestedSentence:   p.String(0, "requested sentence"),
 	}, p.Err()
 }
+
+// EncodeFields returns the Query sentence fields in wire format
+func (s Query) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.RequestedSentence)
+	return e.Fields()
+}
+
+// NewQuery creates query sentence from listener with talkerID requesting sentence of sentenceType from talker with
+// destinationTalkerID.
+//
+// Example: NewQuery("CC", "GP", "GGA") creates $CCGPQ,GGA*2B
+func NewQuery(talkerID, destinationTalkerID, sentenceType string) (Query, error) {
+	if !isAddressPart(talkerID, 2) || !isAddressPart(destinationTalkerID, 2) {
+		return Query{}, fmt.Errorf("nmea: query talker IDs must be 2 characters [%s, %s]", talkerID, destinationTalkerID)
+	}
+	if !isAddressPart(sentenceType, 3) {
+		return Query{}, fmt.Errorf("nmea: query sentence type must be 3 characters [%s]", sentenceType)
+	}
+	q := Query{
+		BaseSentence: BaseSentence{
+			Talker: talkerID,
+			Type:   TypeQuery,
+			Fields: []string{sentenceType},
+		},
+		DestinationTalkerID: destinationTalkerID,
+		RequestedSentence:   sentenceType,
+	}
+	raw, err := Encode(q)
+	if err != nil {
+		return Query{}, err
+	}
+	q.Raw = raw
+	q.Checksum = raw[len(raw)-2:]
+	return q, nil
+}
+
+// isAddressPart checks that value has given length and consists of upper case letters and digits
+func isAddressPart(value string, length int) bool {
+	if len(value) != length {
+		return false
+	}
+	for _, c := range value {
+		if !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// SentenceCache stores the latest sentence per talker ID and sentence type. Query sentences are not stored.
+// SentenceCache is safe for concurrent use by multiple goroutines. Zero value is ready to use.
+type SentenceCache struct {
+	mu        sync.RWMutex
+	sentences map[sentenceCacheKey]Sentence
+}
+
+type sentenceCacheKey struct {
+	talkerID     string
+	sentenceType string
+}
+
+// Add stores the sentence replacing previous sentence with same talker ID and sentence type
+func (c *SentenceCache) Add(s Sentence) {
+	if _, ok := s.(Query); ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sentences == nil {
+		c.sentences = map[sentenceCacheKey]Sentence{}
+	}
+	c.sentences[sentenceCacheKey{talkerID: s.TalkerID(), sentenceType: s.DataType()}] = s
+}
+
+// Get returns the latest sentence with talker ID and sentence type
+func (c *SentenceCache) Get(talkerID, sentenceType string) (Sentence, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.sentences[sentenceCacheKey{talkerID: talkerID, sentenceType: sentenceType}]
+	return s, ok
+}
+
+// QueryResponder answers query sentences with the latest sentences from Cache. It can be used to emulate
+// instruments that reply to polls.
+type QueryResponder struct {
+	Cache *SentenceCache
+}
+
+// Respond returns the latest sentence requested by the query, sent by the talker the query is addressed to.
+// Returns false when there is no such sentence in the cache.
+func (r *QueryResponder) Respond(q Query) (Sentence, bool) {
+	if r.Cache == nil {
+		return nil, false
+	}
+	return r.Cache.Get(q.DestinationTalkerID, q.RequestedSentence)
+}
@@ -42,3 +42,81 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestNewQuery(t *testing.T) {
+	var tests = []struct {
+		name        string
+		talkerID    string
+		destination string
+		typ         string
+		raw         string
+		err         string
+	}{
+		{name: "GGA", talkerID: "CC", destination: "GP", typ: "GGA", raw: "$CCGPQ,GGA*2B"},
+		{name: "invalid talker", talkerID: "C", destination: "GP", typ: "GGA", err: "nmea: query talker IDs must be 2 characters [C, GP]"},
+		{name: "invalid destination", talkerID: "CC", destination: "gp", typ: "GGA", err: "nmea: query talker IDs must be 2 characters [CC, gp]"},
+		{name: "invalid type", talkerID: "CC", destination: "GP", typ: "GGAA", err: "nmea: query sentence type must be 3 characters [GGAA]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewQuery(tt.talkerID, tt.destination, tt.typ)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.raw, q.String())
+
+			parsed, err := Parse(q.String())
+			assert.NoError(t, err)
+			assert.Equal(t, q, parsed)
+		})
+	}
+}
+
+func TestQueryResponder_Respond(t *testing.T) {
+	var cache SentenceCache
+	for _, raw := range []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C",
+		"$GPHDT,274.07,T*03",
+		"$GPHDT,123.456,T*32",
+		"$CCGPQ,GGA*2B",
+	} {
+		s, err := Parse(raw)
+		assert.NoError(t, err)
+		cache.Add(s)
+	}
+	r := QueryResponder{Cache: &cache}
+
+	var tests = []struct {
+		name   string
+		query  string
+		expect string
+	}{
+		{name: "GGA", query: "$CCGPQ,GGA*2B", expect: "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51"},
+		{name: "latest HDT", query: "$CCGPQ,HDT*32", expect: "$GPHDT,123.456,T*32"},
+		{name: "other talker", query: "$CCGNQ,GGA*35", expect: "$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C"},
+		{name: "not in cache", query: "$CCGPQ,RMC*36"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+			assert.NoError(t, err)
+			s, ok := r.Respond(q.(Query))
+			if tt.expect == "" {
+				assert.False(t, ok)
+				assert.Nil(t, s)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.expect, s.String())
+		})
+	}
+
+	_, ok := cache.Get("CC", TypeQuery)
+	assert.False(t, ok, "queries are not cached")
+
+	_, ok = (&QueryResponder{}).Respond(Query{DestinationTalkerID: "GP", RequestedSentence: "GGA"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,126 @@
+package nmea
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ParserRegistry is a set of parsers for sentence types (any talker) or for talker and sentence type pairs.
+//
+// ParserRegistry is safe for concurrent use by multiple goroutines. Lookups do not lock: registered parsers are
+// kept in an immutable table that is replaced (copy-on-write) by Register and Unregister.
+type ParserRegistry struct {
+	mu    sync.Mutex   // serialises writers
+	table atomic.Value // *parserTable
+}
+
+// parserTable is immutable set of registered parsers
+type parserTable struct {
+	byType       map[string]ParserFunc // key: sentence type
+	byTalkerType map[string]ParserFunc // key: talker ID + sentence type
+}
+
+// NewParserRegistry creates new empty ParserRegistry
+func NewParserRegistry() *ParserRegistry {
+	r := &ParserRegistry{}
+	r.table.Store(&parserTable{})
+	return r
+}
+
+func (r *ParserRegistry) load() *parserTable {
+	t, _ := r.table.Load().(*parserTable)
+	if t == nil {
+		return &parserTable{}
+	}
+	return t
+}
+
+// Register registers parser for the sentence type. When talkerID is empty the parser is used for sentences from
+// any talker, otherwise only for sentences from the given talker. Registering a parser for already registered
+// talker and sentence type results in an error.
+func (r *ParserRegistry) Register(talkerID, sentenceType string, parser ParserFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.load()
+	if talkerID == "" {
+		if _, ok := current.byType[sentenceType]; ok {
+			return fmt.Errorf("nmea: parser for sentence type '%q' already exists", sentenceType)
+		}
+	} else if _, ok := current.byTalkerType[talkerID+sentenceType]; ok {
+		return fmt.Errorf("nmea: parser for talker '%q' sentence type '%q' already exists", talkerID, sentenceType)
+	}
+
+	t := current.clone()
+	if talkerID == "" {
+		t.byType[sentenceType] = parser
+	} else {
+		t.byTalkerType[talkerID+sentenceType] = parser
+	}
+	r.table.Store(t)
+	return nil
+}
+
+// Unregister removes parser registered for the talker and sentence type. Empty talkerID removes parser that is
+// used for sentences from any talker. Returns false when there was no such parser.
+func (r *ParserRegistry) Unregister(talkerID, sentenceType string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.load()
+	key, m := sentenceType, current.byType
+	if talkerID != "" {
+		key, m = talkerID+sentenceType, current.byTalkerType
+	}
+	if _, ok := m[key]; !ok {
+		return false
+	}
+
+	t := current.clone()
+	if talkerID == "" {
+		delete(t.byType, key)
+	} else {
+		delete(t.byTalkerType, key)
+	}
+	r.table.Store(t)
+	return true
+}
+
+// Lookup returns parser for the talker and sentence type. Parser registered for exact talker and sentence type is
+// preferred over parser registered for the sentence type only.
+func (r *ParserRegistry) Lookup(talkerID, sentenceType string) (ParserFunc, bool) {
+	t := r.load()
+	if parser, ok := t.byTalkerType[talkerID+sentenceType]; ok {
+		return parser, true
+	}
+	parser, ok := t.byType[sentenceType]
+	return parser, ok
+}
+
+// LookupType returns parser registered for the sentence type (any talker).
+func (r *ParserRegistry) LookupType(sentenceType string) (ParserFunc, bool) {
+	parser, ok := r.load().byType[sentenceType]
+	return parser, ok
+}
+
+// Clone returns a copy of the registry. Changes to the copy do not affect the original registry and vice versa.
+func (r *ParserRegistry) Clone() *ParserRegistry {
+	c := &ParserRegistry{}
+	c.table.Store(r.load()) // table is immutable so it can be shared
+	return c
+}
+
+func (t *parserTable) clone() *parserTable {
+	c := &parserTable{
+		byType:       make(map[string]ParserFunc, len(t.byType)+1),
+		byTalkerType: make(map[string]ParserFunc, len(t.byTalkerType)+1),
+	}
+	for k, v := range t.byType {
+		c.byType[k] = v
+	}
+	for k, v := range t.byTalkerType {
+		c.byTalkerType[k] = v
+	}
+	return c
+}
@@ -0,0 +1,154 @@
+package nmea
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type registryTestSentence struct {
+	BaseSentence
+	Parser string
+}
+
+func registryTestParser(name string) ParserFunc {
+	return func(s BaseSentence) (Sentence, error) {
+		return registryTestSentence{BaseSentence: s, Parser: name}, nil
+	}
+}
+
+func TestParserRegistry_Lookup(t *testing.T) {
+	r := NewParserRegistry()
+	assert.NoError(t, r.Register("", "XYZ", registryTestParser("any talker")))
+	assert.NoError(t, r.Register("AA", "XYZ", registryTestParser("AA talker")))
+
+	var testCases = []struct {
+		name       string
+		whenTalker string
+		whenType   string
+		expect     string
+	}{
+		{name: "ok, talker and type", whenTalker: "AA", whenType: "XYZ", expect: "AA talker"},
+		{name: "ok, type only", whenTalker: "BB", whenType: "XYZ", expect: "any talker"},
+		{name: "nok, unknown type", whenTalker: "AA", whenType: "ABC"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser, ok := r.Lookup(tc.whenTalker, tc.whenType)
+			if tc.expect == "" {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			s, err := parser(BaseSentence{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, s.(registryTestSentence).Parser)
+		})
+	}
+
+	parser, ok := r.LookupType("XYZ")
+	assert.True(t, ok)
+	s, _ := parser(BaseSentence{})
+	assert.Equal(t, "any talker", s.(registryTestSentence).Parser)
+}
+
+func TestParserRegistry_RegisterDuplicate(t *testing.T) {
+	r := NewParserRegistry()
+	assert.NoError(t, r.Register("", "XYZ", registryTestParser("a")))
+	assert.NoError(t, r.Register("AA", "XYZ", registryTestParser("b")))
+
+	err := r.Register("", "XYZ", registryTestParser("c"))
+	assert.EqualError(t, err, `nmea: parser for sentence type '"XYZ"' already exists`)
+
+	err = r.Register("AA", "XYZ", registryTestParser("d"))
+	assert.EqualError(t, err, `nmea: parser for talker '"AA"' sentence type '"XYZ"' already exists`)
+}
+
+func TestParserRegistry_Unregister(t *testing.T) {
+	r := NewParserRegistry()
+	assert.NoError(t, r.Register("", "XYZ", registryTestParser("any talker")))
+	assert.NoError(t, r.Register("AA", "XYZ", registryTestParser("AA talker")))
+
+	assert.True(t, r.Unregister("AA", "XYZ"))
+	assert.False(t, r.Unregister("AA", "XYZ"))
+
+	parser, ok := r.Lookup("AA", "XYZ")
+	assert.True(t, ok)
+	s, _ := parser(BaseSentence{})
+	assert.Equal(t, "any talker", s.(registryTestSentence).Parser)
+
+	assert.True(t, r.Unregister("", "XYZ"))
+	_, ok = r.Lookup("AA", "XYZ")
+	assert.False(t, ok)
+}
+
+func TestParserRegistry_Clone(t *testing.T) {
+	r := NewParserRegistry()
+	assert.NoError(t, r.Register("", "XYZ", registryTestParser("original")))
+
+	c := r.Clone()
+	assert.NoError(t, c.Register("", "ABC", registryTestParser("clone")))
+	assert.True(t, c.Unregister("", "XYZ"))
+
+	_, ok := r.LookupType("ABC")
+	assert.False(t, ok)
+	_, ok = r.LookupType("XYZ")
+	assert.True(t, ok)
+
+	_, ok = c.LookupType("ABC")
+	assert.True(t, ok)
+	_, ok = c.LookupType("XYZ")
+	assert.False(t, ok)
+}
+
+func TestParserRegistry_ZeroValue(t *testing.T) {
+	var r ParserRegistry
+	_, ok := r.Lookup("AA", "XYZ")
+	assert.False(t, ok)
+	assert.NoError(t, r.Register("", "XYZ", registryTestParser("a")))
+	_, ok = r.Lookup("AA", "XYZ")
+	assert.True(t, ok)
+}
+
+func TestSentenceParser_Registry(t *testing.T) {
+	r := NewParserRegistry()
+	assert.NoError(t, r.Register("AA", "YYY", registryTestParser("AA talker")))
+	p := SentenceParser{Registry: r}
+
+	s, err := p.Parse("$AAYYY,20,one,*13")
+	assert.NoError(t, err)
+	assert.Equal(t, "AA talker", s.(registryTestSentence).Parser)
+
+	_, err = p.Parse("$BBYYY,20,one,*13")
+	assert.EqualError(t, err, "nmea: sentence prefix 'BBYYY' not supported")
+
+	// custom parsers take precedence over registry
+	p.CustomParsers = map[string]ParserFunc{"YYY": registryTestParser("custom")}
+	s, err = p.Parse("$AAYYY,20,one,*13")
+	assert.NoError(t, err)
+	assert.Equal(t, "custom", s.(registryTestSentence).Parser)
+}
+
+func TestSentenceParser_ConcurrentParseAndRegister(t *testing.T) {
+	r := NewParserRegistry()
+	p := SentenceParser{Registry: r}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s, err := p.Parse("!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+				assert.NoError(t, err)
+				assert.Equal(t, TypeVDM, s.DataType())
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, r.Register("", "XYZ", registryTestParser("a")))
+		assert.True(t, r.Unregister("", "XYZ"))
+	}
+	wg.Wait()
+}
@@ -66,7 +66,7 @@ type RMB struct {
 
 // newRMB constructor
 func newRMB(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeRMB)
 	rmb := RMB{
 		BaseSentence:                    s,
@@ -88,3 +88,28 @@ func newRMB(s BaseSentence) (Sentence, error) {
 	}
 	return rmb, p.Err()
 }
+
+// DestinationPosition returns position of the destination waypoint
+func (s RMB) DestinationPosition() Position {
+	return Position{Latitude: s.DestinationLatitude, Longitude: s.DestinationLongitude}
+}
+
+// EncodeFields returns the RMB sentence fields in wire format
+func (s RMB) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.DataStatus)
+	e.Float64(s.CrossTrackErrorNauticalMiles, 3)
+	e.String(s.DirectionToSteer)
+	e.String(s.OriginWaypointID)
+	e.String(s.DestinationWaypointID)
+	e.Latitude(s.DestinationLatitude, "latitude")
+	e.Longitude(s.DestinationLongitude, "longitude")
+	e.Float64(s.RangeToDestinationNauticalMiles, 3)
+	e.Float64(s.TrueBearingToDestination, 3)
+	e.Float64(s.VelocityToDestinationKnots, 3)
+	e.String(s.ArrivalStatus)
+	if len(s.Fields) > 13 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
@@ -1,5 +1,10 @@
 package nmea
 
+import (
+	"math"
+	"time"
+)
+
 const (
 	// TypeRMC type for RMC sentences
 	TypeRMC = "RMC"
@@ -30,14 +35,21 @@ type RMC struct {
 	Date      Date    // Date
 	Variation float64 // Magnetic variation
 	FFAMode   string  // FAA mode indicator (filled in NMEA 2.3 and later)
-	NavStatus string  // Nav Status (NMEA 4.1 and later)
+	NavStatus string  // Nav Status (NMEA 4.10 and later)
 }
 
 // newRMC constructor
 func newRMC(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	m := RMC{}
+	err := m.Decode(s)
+	return m, err
+}
+
+// Decode parses base sentence into RMC. Implements Decodable.
+func (m *RMC) Decode(s BaseSentence) error {
+	p := newParser(s)
 	p.AssertType(TypeRMC)
-	m := RMC{
+	*m = RMC{
 		BaseSentence: s,
 		Time:         p.Time(0, "time"),
 		Validity:     p.EnumString(1, "validity", ValidRMC, InvalidRMC),
@@ -54,7 +66,7 @@ func newRMC(s BaseSentence) (Sentence, error) {
 	if len(p.Fields) > 11 {
 		m.FFAMode = p.String(11, "FAA mode") // not enum because some devices have proprietary "non-nmea" values
 	}
-	if len(p.Fields) > 12 {
+	if p.versionedField(12, "navigation status", m.Layout(), Version410) {
 		m.NavStatus = p.EnumString(
 			12,
 			"navigation status",
@@ -64,5 +76,60 @@ func newRMC(s BaseSentence) (Sentence, error) {
 			NavStatusNotValid,
 		)
 	}
-	return m, p.Err()
+	return p.Err()
+}
+
+// Layout returns NMEA 0183 version of the sentence layout: configured version (BaseSentence.Version) or version
+// detected from the number of fields.
+func (s RMC) Layout() Version {
+	if len(s.Fields) > 12 {
+		return s.versionOr(Version410)
+	}
+	return s.versionOr(Version2)
+}
+
+// Timestamp returns UTC time of the sentence. Century of the date is resolved with the reference time and date is
+// corrected for GPS week rollover, see ResolveDateTime.
+func (s RMC) Timestamp(ref time.Time) (time.Time, error) {
+	return ResolveDateTime(ref, s.Date, s.Time)
+}
+
+// Position returns position of the fix
+func (s RMC) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// FillVariation returns copy of the sentence with missing variation set to magnetic declination (degrees, positive
+// east, see MagneticDeclination). Variation is missing when its field is empty in parsed sentence or when it is zero
+// in sentence without fields. Direction of the variation is encoded from the sign.
+func (s RMC) FillVariation(declination float64) RMC {
+	if !valuePresent(s.BaseSentence, 9, s.Variation) {
+		s.Variation = declination
+	}
+	return s
+}
+
+// EncodeFields returns the RMC sentence fields in wire format
+func (s RMC) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.String(s.Validity)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.Float64(s.Speed, 3)
+	e.Float64(s.Course, 3)
+	e.Date(s.Date, "date")
+	e.Float64(math.Abs(s.Variation), 3)
+	e.Direction(s.Variation, East, West)
+	navStatus := len(s.Fields) > 12 || s.NavStatus != ""
+	if v := s.configuredVersion(); v != VersionUnknown {
+		navStatus = v >= Version410
+	}
+	if len(s.Fields) > 11 || s.FFAMode != "" || navStatus {
+		e.String(s.FFAMode)
+	}
+	if navStatus {
+		e.String(s.NavStatus)
+	}
+	return e.Fields()
 }
@@ -21,7 +21,7 @@ type ROT struct {
 }
 
 func newROT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeROT)
 	return ROT{
 		BaseSentence: s,
@@ -29,3 +29,11 @@ func newROT(s BaseSentence) (Sentence, error) {
 		Valid:        p.EnumString(1, "status valid", ValidROT, InvalidROT) == ValidROT,
 	}, p.Err()
 }
+
+// EncodeFields returns the ROT sentence fields in wire format
+func (s ROT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.RateOfTurn, 3)
+	e.Bool(s.Valid, ValidROT, InvalidROT)
+	return e.Fields()
+}
@@ -26,7 +26,7 @@ type RPM struct {
 
 // newRPM constructor
 func newRPM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeRPM)
 	return RPM{
 		BaseSentence: s,
@@ -37,3 +37,14 @@ func newRPM(s BaseSentence) (Sentence, error) {
 		Status:       p.EnumString(4, "status", StatusValid, StatusInvalid),
 	}, p.Err()
 }
+
+// EncodeFields returns the RPM sentence fields in wire format
+func (s RPM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.Source)
+	e.Int64(s.EngineNumber)
+	e.Float64(s.SpeedRPM, 1)
+	e.Float64(s.PitchPercent, 1)
+	e.String(s.Status)
+	return e.Fields()
+}
@@ -20,7 +20,7 @@ type RSA struct {
 
 // newRSA constructor
 func newRSA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeRSA)
 	return RSA{
 		BaseSentence:               s,
@@ -30,3 +30,13 @@ func newRSA(s BaseSentence) (Sentence, error) {
 		PortRudderAngleStatus:      p.EnumString(3, "port rudder angle status", StatusValid, StatusInvalid),
 	}, p.Err()
 }
+
+// EncodeFields returns the RSA sentence fields in wire format
+func (s RSA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.StarboardRudderAngle, 3)
+	e.String(s.StarboardRudderAngleStatus)
+	e.Float64(s.PortRudderAngle, 3)
+	e.String(s.PortRudderAngleStatus)
+	return e.Fields()
+}
@@ -42,7 +42,7 @@ type RSD struct {
 
 // newRSD constructor
 func newRSD(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeRSD)
 	return RSD{
 		BaseSentence:         s,
@@ -64,3 +64,22 @@ func newRSD(s BaseSentence) (Sentence, error) {
 		DisplayRotation: p.EnumString(12, "display rotation", RSDDisplayRotationCourseUp, RSDDisplayRotationHeadingUp, RSDDisplayRotationNorthUp),
 	}, p.Err()
 }
+
+// EncodeFields returns the RSD sentence fields in wire format
+func (s RSD) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Origin1Range, 3)
+	e.Float64(s.Origin1Bearing, 3)
+	e.Float64(s.VariableRangeMarker1, 3)
+	e.Float64(s.BearingLine1, 3)
+	e.Float64(s.Origin2Range, 3)
+	e.Float64(s.Origin2Bearing, 3)
+	e.Float64(s.VariableRangeMarker2, 3)
+	e.Float64(s.BearingLine2, 3)
+	e.Float64(s.CursorRangeFromOwnShip, 3)
+	e.Float64(s.CursorBearingDegrees, 3)
+	e.Float64(s.RangeScale, 3)
+	e.String(s.RangeUnit)
+	e.String(s.DisplayRotation)
+	return e.Fields()
+}
@@ -28,7 +28,7 @@ type RTE struct {
 
 // newRTE constructor
 func newRTE(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeRTE)
 	return RTE{
 		BaseSentence:              s,
@@ -39,3 +39,19 @@ func newRTE(s BaseSentence) (Sentence, error) {
 		Idents:                    p.ListString(4, "ident of waypoints"),
 	}, p.Err()
 }
+
+// EncodeFields returns the RTE sentence fields in wire format
+func (s RTE) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.NumberOfSentences)
+	e.Int64(s.SentenceNumber)
+	e.String(s.ActiveRouteOrWaypointList)
+	e.String(s.Name)
+	if len(s.Idents) == 0 {
+		e.String("") // waypoint list field is mandatory
+	}
+	for _, ident := range s.Idents {
+		e.String(ident)
+	}
+	return e.Fields()
+}
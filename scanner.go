@@ -0,0 +1,215 @@
+package nmea
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// DefaultMaxLineLength is the default maximum length of a line (tag block included) accepted by Scanner.
+// NMEA0183 limits sentences to 82 characters but tag blocks and off-spec devices produce longer lines.
+const DefaultMaxLineLength = 1024
+
+var (
+	// ErrLineTooLong is returned by Scanner for lines that exceed the maximum line length
+	ErrLineTooLong = errors.New("nmea: line exceeds maximum line length")
+	// ErrTruncatedLine is returned by Scanner for lines that were interrupted by start of the next sentence
+	ErrTruncatedLine = errors.New("nmea: line is truncated by start of next sentence")
+)
+
+// Scanner reads sentences line by line from an io.Reader (serial port, socket, file etc).
+// Lines can be terminated by CR, LF or CRLF. Bytes before sentence start (`$`, `!`) or tag block start (`\`)
+// are dropped and counted as skipped. Invalid lines do not stop the scanning, their error is available with
+// SentenceErr and scanning continues with the next line.
+//
+// Example:
+//
+//	scanner := nmea.NewScanner(conn)
+//	for scanner.Scan() {
+//		if err := scanner.SentenceErr(); err != nil {
+//			log.Printf("invalid line %q: %v", scanner.Raw(), err)
+//			continue
+//		}
+//		handle(scanner.Sentence())
+//	}
+//	if err := scanner.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// Scanner is not co-routine safe!
+type Scanner struct {
+	// Parser is used to parse scanned lines. When nil, the package level Parse function is used.
+	Parser *SentenceParser
+
+	// MaxLineLength is maximum accepted line length in bytes. When 0, DefaultMaxLineLength is used.
+	MaxLineLength int
+
+	r   *bufio.Reader
+	err error
+
+	line        []byte
+	sentence    Sentence
+	sentenceErr error
+
+	skippedBytes int64
+	lines        int64
+	badLines     int64
+}
+
+// NewScanner creates new Scanner reading from r
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: bufio.NewReader(r)}
+}
+
+// Scan advances the Scanner to the next line, which will then be available through the Sentence, SentenceErr and
+// Raw methods. It returns false when the scan stops, either by reaching the end of the input or a read error.
+// After Scan returns false, the Err method will return any error that occurred during scanning, except that
+// if it was io.EOF, Err will return nil.
+func (s *Scanner) Scan() bool {
+	s.sentence = nil
+	s.sentenceErr = nil
+	s.line = s.line[:0]
+	if s.err != nil {
+		return false
+	}
+
+	framingErr := s.readLine()
+	if len(s.line) == 0 {
+		return false
+	}
+	s.lines++
+	if framingErr != nil {
+		s.sentenceErr = framingErr
+		s.badLines++
+		return true
+	}
+
+	if s.Parser != nil {
+		s.sentence, s.sentenceErr = s.Parser.Parse(string(s.line))
+	} else {
+		s.sentence, s.sentenceErr = Parse(string(s.line))
+	}
+	if s.sentenceErr != nil {
+		s.badLines++
+	}
+	return true
+}
+
+// readLine reads bytes into s.line until end of line, end of input or the start of next sentence. Returned error is
+// a framing error of the current line, read errors are stored in s.err.
+func (s *Scanner) readLine() error {
+	maxLength := s.MaxLineLength
+	if maxLength <= 0 {
+		maxLength = DefaultMaxLineLength
+	}
+	var (
+		inTagBlock bool
+		inSentence bool
+		tooLong    bool
+	)
+	for {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			s.err = err
+			break
+		}
+
+		if len(s.line) == 0 && !tooLong {
+			// resynchronisation: drop everything that can not be a start of line
+			switch b {
+			case SentenceStart[0], SentenceStartEncapsulated[0]:
+				inSentence = true
+			case TagBlockSep:
+				inTagBlock = true
+			case '\r', '\n': // empty lines and second half of CRLF are not garbage
+				continue
+			default:
+				s.skippedBytes++
+				continue
+			}
+			s.line = append(s.line, b)
+			continue
+		}
+
+		switch b {
+		case '\r', '\n':
+			if tooLong {
+				return ErrLineTooLong
+			}
+			return nil
+		case SentenceStart[0], SentenceStartEncapsulated[0], TagBlockSep:
+			if inTagBlock {
+				if b == TagBlockSep {
+					inTagBlock = false
+				}
+				break
+			}
+			if !inSentence && !tooLong {
+				inSentence = true
+				break
+			}
+			// start of a new line while still in sentence part means that current line was cut short
+			if err := s.r.UnreadByte(); err != nil {
+				s.err = err
+			}
+			if tooLong {
+				return ErrLineTooLong
+			}
+			return ErrTruncatedLine
+		}
+
+		if tooLong {
+			s.skippedBytes++
+			continue
+		}
+		if len(s.line) >= maxLength {
+			tooLong = true
+			s.skippedBytes++
+			continue
+		}
+		s.line = append(s.line, b)
+	}
+	if tooLong {
+		return ErrLineTooLong
+	}
+	return nil
+}
+
+// Sentence returns the sentence parsed from the most recent line or nil when the line was invalid.
+func (s *Scanner) Sentence() Sentence {
+	return s.sentence
+}
+
+// SentenceErr returns the error of the most recent line (framing or parsing error) or nil when the line was valid.
+func (s *Scanner) SentenceErr() error {
+	return s.sentenceErr
+}
+
+// Raw returns the most recent line as it was read (without line terminator).
+func (s *Scanner) Raw() string {
+	return string(s.line)
+}
+
+// Err returns the first non-EOF error that was encountered while reading from the io.Reader.
+func (s *Scanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
+
+// SkippedBytes returns the number of bytes dropped while synchronising to the start of lines or that were
+// truncated from lines longer than maximum line length.
+func (s *Scanner) SkippedBytes() int64 {
+	return s.skippedBytes
+}
+
+// Lines returns the number of lines scanned so far.
+func (s *Scanner) Lines() int64 {
+	return s.lines
+}
+
+// BadLines returns the number of lines that resulted in a framing or parsing error.
+func (s *Scanner) BadLines() int64 {
+	return s.badLines
+}
@@ -0,0 +1,176 @@
+package nmea
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type scannedLine struct {
+	raw      string
+	sentence Sentence
+	err      string
+}
+
+func TestScanner(t *testing.T) {
+	var testCases = []struct {
+		name          string
+		givenMaxLen   int
+		whenInput     string
+		expect        []scannedLine
+		expectSkipped int64
+		expectBad     int64
+	}{
+		{
+			name:      "ok, CRLF terminated lines",
+			whenInput: "$HEROT,-11.23,A*07\r\n$HEHDT,274.07,T*19\r\n",
+			expect: []scannedLine{
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+				{raw: "$HEHDT,274.07,T*19", sentence: HDT{BaseSentence: BaseSentence{Talker: "HE", Type: "HDT", Fields: []string{"274.07", "T"}, Checksum: "19", Raw: "$HEHDT,274.07,T*19"}, Heading: 274.07, True: true}},
+			},
+		},
+		{
+			name:      "ok, CR and LF terminated lines and last line without terminator",
+			whenInput: "$HEROT,-11.23,A*07\r$HEROT,-11.23,A*07\n\n$HEROT,-11.23,A*07",
+			expect: []scannedLine{
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+			},
+		},
+		{
+			name:      "ok, garbage before sentence start is skipped",
+			whenInput: "\x00\xffnoise$HEROT,-11.23,A*07\r\n",
+			expect: []scannedLine{
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+			},
+			expectSkipped: 7,
+		},
+		{
+			name:      "ok, tag block line",
+			whenInput: "\\s:somewhere,c:1720289719*4D\\!AIVDM,1,1,,A,,0*26\r\n",
+			expect: []scannedLine{
+				{
+					raw: "\\s:somewhere,c:1720289719*4D\\!AIVDM,1,1,,A,,0*26",
+					sentence: VDMVDO{
+						BaseSentence: BaseSentence{
+							Talker:   "AI",
+							Type:     "VDM",
+							Fields:   []string{"1", "1", "", "A", "", "0"},
+							Checksum: "26",
+							Raw:      "!AIVDM,1,1,,A,,0*26",
+							TagBlock: TagBlock{Time: 1720289719, Source: "somewhere"},
+						},
+						NumFragments:   1,
+						FragmentNumber: 1,
+						Channel:        "A",
+						Payload:        []uint8{},
+					},
+				},
+			},
+		},
+		{
+			name:      "ok, bad lines do not stop scanning",
+			whenInput: "$HEROT,-11.23,A*FF\r\n$HEROT,-11.23,A*07\r\n",
+			expect: []scannedLine{
+				{raw: "$HEROT,-11.23,A*FF", err: "nmea: sentence checksum mismatch [07 != FF]"},
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+			},
+			expectBad: 1,
+		},
+		{
+			name:      "ok, truncated sentence is resynchronised at next sentence start",
+			whenInput: "$HEROT,-11.2$HEROT,-11.23,A*07\r\n",
+			expect: []scannedLine{
+				{raw: "$HEROT,-11.2", err: ErrTruncatedLine.Error()},
+				{raw: "$HEROT,-11.23,A*07", sentence: ROT{BaseSentence: BaseSentence{Talker: "HE", Type: "ROT", Fields: []string{"-11.23", "A"}, Checksum: "07", Raw: "$HEROT,-11.23,A*07"}, RateOfTurn: -11.23, Valid: true}},
+			},
+			expectBad: 1,
+		},
+		{
+			name:        "ok, too long line is reported and the remainder skipped",
+			givenMaxLen: 10,
+			whenInput:   "$HEROT,-11.23,A*07\r\n",
+			expect: []scannedLine{
+				{raw: "$HEROT,-11", err: ErrLineTooLong.Error()},
+			},
+			expectSkipped: 8,
+			expectBad:     1,
+		},
+		{
+			name:      "ok, empty input",
+			whenInput: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewScanner(strings.NewReader(tc.whenInput))
+			s.MaxLineLength = tc.givenMaxLen
+
+			var result []scannedLine
+			for s.Scan() {
+				line := scannedLine{raw: s.Raw(), sentence: s.Sentence()}
+				if err := s.SentenceErr(); err != nil {
+					line.err = err.Error()
+				}
+				result = append(result, line)
+			}
+
+			assert.NoError(t, s.Err())
+			assert.Equal(t, tc.expect, result)
+			assert.Equal(t, int64(len(tc.expect)), s.Lines())
+			assert.Equal(t, tc.expectSkipped, s.SkippedBytes())
+			assert.Equal(t, tc.expectBad, s.BadLines())
+		})
+	}
+}
+
+func TestScanner_Parser(t *testing.T) {
+	var tagBlocks []TagBlock
+	s := NewScanner(strings.NewReader("\\s:r1*0A\\$AAYYY,20,one,*13\r\n"))
+	s.Parser = &SentenceParser{
+		CustomParsers: map[string]ParserFunc{
+			"YYY": func(s BaseSentence) (Sentence, error) {
+				return s, nil
+			},
+		},
+		OnTagBlock: func(tagBlock TagBlock) error {
+			tagBlocks = append(tagBlocks, tagBlock)
+			return nil
+		},
+	}
+
+	assert.True(t, s.Scan())
+	assert.NoError(t, s.SentenceErr())
+	assert.Equal(t, "YYY", s.Sentence().DataType())
+	assert.False(t, s.Scan())
+	assert.Equal(t, []TagBlock{{Source: "r1"}}, tagBlocks)
+}
+
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestScanner_ReadError(t *testing.T) {
+	readErr := errors.New("serial port closed")
+	s := NewScanner(&failingReader{data: "$HEROT,-11.23,A*07\r\n$HEROT", err: readErr})
+
+	assert.True(t, s.Scan())
+	assert.NoError(t, s.SentenceErr())
+	assert.True(t, s.Scan())
+	assert.EqualError(t, s.SentenceErr(), "nmea: sentence does not contain checksum separator")
+	assert.False(t, s.Scan())
+	assert.Equal(t, readErr, s.Err())
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"sync"
 )
 
 const (
@@ -59,6 +58,19 @@ type BaseSentence struct {
 	Checksum string   // The (raw) Checksum
 	Raw      string   // The raw NMEA sentence received
 	TagBlock TagBlock // NMEA tagblock
+
+	// ChecksumStatus tells if the sentence was received with checksum and if the checksum was valid. Sentences with
+	// missing or invalid checksum are parsed only when allowed by SentenceParser.ChecksumPolicy.
+	ChecksumStatus ChecksumStatus
+
+	// Version is NMEA 0183 version of the sentence talker configured in SentenceParser (see SentenceParser.Version
+	// and TalkerVersions) or detected from earlier sentences of the talker (see SentenceParser.VersionDetector).
+	// VersionUnknown when not configured. Sentences with version dependent layout report the layout they matched
+	// with their Layout method.
+	Version Version
+	// versionDetected is set when Version was detected from earlier sentences of the talker. Detected version is the
+	// minimum layout of version dependent sentences, their layout is still detected from the number of fields.
+	versionDetected bool
 }
 
 // Prefix returns the talker and type of message
@@ -81,20 +93,38 @@ func (s BaseSentence) String() string { return s.Raw }
 
 // SentenceParser is configurable parser instance to parse raw input into NMEA0183 Sentence
 //
-// SentenceParser fields/methods are not co-routine safe!
+// SentenceParser is safe for concurrent use by multiple goroutines as long as its fields are not modified while
+// parsing. Use Registry to add or remove parsers while SentenceParser is in use.
 type SentenceParser struct {
 	// CustomParsers allows registering additional parsers
 	CustomParsers map[string]ParserFunc
 
+	// Registry allows registering additional parsers for sentence types or talker and sentence type pairs. Registry
+	// is consulted after CustomParsers and can be modified while SentenceParser is in use.
+	Registry *ParserRegistry
+
+	// Metadata contains sentence types with their parsers. It is consulted after Registry. When nil, registry with
+	// built-in sentences (DefaultMetadataRegistry) is used.
+	Metadata *MetadataRegistry
+
 	// ParsePrefix takes in the sentence first field (NMEA0183 address) and splits it into a talker id and sentence type
 	ParsePrefix func(prefix string) (talkerID string, sentence string, err error)
 
-	// CheckCRC allows custom handling of checksum checking based on parsed sentence
+	// CheckCRC allows custom handling of checksum checking based on parsed sentence. It is used for sentences with
+	// ChecksumRequire policy.
 	CheckCRC func(sentence BaseSentence, rawFields string) error
 
+	// ChecksumPolicy is the checksum policy for sentences that do not match any of ChecksumPolicies rules. Default is
+	// ChecksumRequire.
+	ChecksumPolicy ChecksumPolicy
+
+	// ChecksumPolicies sets checksum policy for sentences of specific talkers and/or sentence types, for example to
+	// accept sentences without checksum from legacy depth sounder. The most specific matching rule is used.
+	ChecksumPolicies []ChecksumPolicyRule
+
 	// OnTagBlock is callback to handle all parsed tag blocks even for lines containing only a tag block and
-	// allows to track multiline tag group separate lines. Logic how to combine/assemble multiline tag group
-	// should be implemented outside SentenceParser
+	// allows to track multiline tag group separate lines. Multiline tag groups can be combined with
+	// TagGroupAssembler.
 	// OnTagBlock is called before actual sentence part is parsed. When callback returns an error sentence parsing will
 	// not be done and Parse returns early with the returned error.
 	//
@@ -107,9 +137,40 @@ type SentenceParser struct {
 	// OnBaseSentence is a callback for accessing/modifying the base sentence
 	// before further parsing is done.
 	OnBaseSentence func(sentence *BaseSentence) error
+
+	// Lenient enables lenient mode. In lenient mode Parse returns populated sentence along with FieldErrors that
+	// contains errors of all invalid fields (with field indices), otherwise only the first field error is returned.
+	// Invalid fields have zero value, nullable fields (Float64, Int64, Time, Date) are marked as not valid.
+	// Lenient mode applies to built-in sentences, sentences of StructParser parsers and custom parsers that return
+	// FieldErrors.
+	Lenient bool
+
+	// Version is NMEA 0183 version of talkers that are not in TalkerVersions. Version selects layout of version
+	// dependent sentences: fields introduced in later versions are not parsed. When VersionUnknown (default), version
+	// is detected from the layout of each sentence.
+	Version Version
+
+	// TalkerVersions sets NMEA 0183 version by talker ID, for example {"GN": nmea.Version410}
+	TalkerVersions map[string]Version
+
+	// VersionDetector enables detection of NMEA 0183 version per talker when Version is VersionUnknown. Version
+	// revealed by the layout of a version dependent sentence (e.g. GSV with signal ID is 4.10) is remembered for the
+	// talker and set to following sentences of the talker. Talkers in TalkerVersions are not detected.
+	VersionDetector *VersionDetector
+
+	// KeepEscapedFields disables decoding of `^hh` hex escape sequences in fields. When set, BaseSentence.Fields
+	// contain fields as they were received.
+	KeepEscapedFields bool
 }
 
 func (p *SentenceParser) parseBaseSentence(raw string) (BaseSentence, error) {
+	var fields []string
+	return p.parseBaseSentenceInto(raw, &fields)
+}
+
+// parseBaseSentenceInto parses base sentence using given buffer for splitting fields. Buffer is reused (resliced)
+// and grown when needed. Address field is the first element of the buffer.
+func (p *SentenceParser) parseBaseSentenceInto(raw string, fieldsBuf *[]string) (BaseSentence, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
 		return BaseSentence{}, errors.New("nmea: can not parse empty input")
@@ -136,9 +197,10 @@ func (p *SentenceParser) parseBaseSentence(raw string) (BaseSentence, error) {
 		rawFields = raw[startIndex+1 : checksumSepIndex]
 		checksumRaw = strings.ToUpper(raw[checksumSepIndex+1:])
 	}
-	// TODO: fields can contain encoded chars that need to be unescaped. `^` 0x5e is escape character for HEX representation of ISO/IEC 8859-1 (ASCII) characters.
-	// TODO: `^` itself is escaped as `^5e` and `,` is escaped as `^2c` etc. All reserved characters should be escaped/unescaped (See wikipedia https://en.wikipedia.org/wiki/NMEA_0183#Message_structure)
-	fields := strings.Split(rawFields, FieldSep)
+	// fields can contain reserved characters escaped as `^hh` (`,` as `^2C`, `^` as `^5E` etc). Escape sequences are
+	// decoded only after splitting so escaped field separators do not split the field.
+	fields := splitFields((*fieldsBuf)[:0], rawFields)
+	*fieldsBuf = fields
 
 	var (
 		talkerID string
@@ -160,24 +222,80 @@ func (p *SentenceParser) parseBaseSentence(raw string) (BaseSentence, error) {
 		Raw:      raw,
 		TagBlock: tagBlock,
 	}
-	if p.CheckCRC == nil {
-		err = CheckCRC(sentence, rawFields)
-	} else {
-		err = p.CheckCRC(sentence, rawFields)
-	}
-	if err != nil {
+	if err := p.checkChecksum(&sentence, rawFields); err != nil {
 		return BaseSentence{}, err
 	}
+	if !p.KeepEscapedFields {
+		if err := unescapeFields(sentence); err != nil {
+			return BaseSentence{}, err
+		}
+	}
 	return sentence, nil
 }
 
+// checkChecksum checks sentence checksum according to checksum policy of the sentence and sets its checksum status
+func (p *SentenceParser) checkChecksum(sentence *BaseSentence, rawFields string) error {
+	calculated := Checksum(rawFields)
+	sentence.ChecksumStatus = checksumStatus(sentence.Checksum, calculated)
+
+	switch p.checksumPolicy(sentence.Talker, sentence.Type) {
+	case ChecksumAcceptMissing:
+		if sentence.ChecksumStatus == ChecksumMismatch {
+			return &ChecksumError{Expected: calculated, Actual: sentence.Checksum}
+		}
+	case ChecksumIgnoreMismatch:
+	case ChecksumRepair:
+		if sentence.ChecksumStatus != ChecksumValid {
+			// raw starts with start delimiter followed by raw fields
+			sentence.Raw = sentence.Raw[:1+len(rawFields)] + ChecksumSep + calculated
+			sentence.Checksum = calculated
+		}
+	default:
+		if p.CheckCRC == nil {
+			return CheckCRC(*sentence, rawFields)
+		}
+		return p.CheckCRC(*sentence, rawFields)
+	}
+	return nil
+}
+
+// splitFields appends all substrings of rawFields separated by FieldSep to dst
+func splitFields(dst []string, rawFields string) []string {
+	start := 0
+	for i := 0; i < len(rawFields); i++ {
+		if rawFields[i] == FieldSep[0] {
+			dst = append(dst, rawFields[start:i])
+			start = i + 1
+		}
+	}
+	return append(dst, rawFields[start:])
+}
+
+// unescapeFields decodes hex escape sequences of sentence fields in place
+func unescapeFields(sentence BaseSentence) error {
+	for i, field := range sentence.Fields {
+		unescaped, invalidAt := unescapeField(field)
+		if invalidAt != -1 {
+			return &FieldError{
+				Prefix:  sentence.Prefix(),
+				Index:   i,
+				Context: fmt.Sprintf("escape sequence in field %d", i),
+				Value:   field,
+				Reason:  fmt.Sprintf("%q", escapeSequenceAt(field, invalidAt)),
+			}
+		}
+		sentence.Fields[i] = unescaped
+	}
+	return nil
+}
+
 // CheckCRC is default implementation for checking sentence Checksum
 func CheckCRC(sentence BaseSentence, rawFields string) error {
 	if sentence.Checksum == "" {
-		return fmt.Errorf("nmea: sentence does not contain checksum separator")
+		return &ChecksumError{Expected: Checksum(rawFields)}
 	}
 	if checksum := Checksum(rawFields); checksum != sentence.Checksum {
-		return fmt.Errorf("nmea: sentence checksum mismatch [%s != %s]", checksum, sentence.Checksum)
+		return &ChecksumError{Expected: checksum, Actual: sentence.Checksum}
 	}
 	return nil
 }
@@ -186,7 +304,7 @@ func CheckCRC(sentence BaseSentence, rawFields string) error {
 // a talker id and sentence type.
 func ParsePrefix(prefix string) (string, string, error) {
 	if prefix == "" {
-		return "", "", errors.New("nmea: sentence prefix is empty")
+		return "", "", &PrefixError{Reason: "is empty"}
 	}
 	// proprietary sentences start with `P` + sentence type. By NMEA0183 spec they should be 5 character long,
 	// In this case we allow sentence type to be longer as there are plenty of examples with longer sentence
@@ -219,17 +337,38 @@ func Checksum(s string) string {
 	for i := 0; i < len(s); i++ {
 		checksum ^= s[i]
 	}
-	return fmt.Sprintf("%02X", checksum)
+	return checksumStrings[checksum]
 }
 
-var defaultSentenceParserMu = new(sync.Mutex)
+// checksumStrings contains all checksums formatted as uppercase hex strings so Checksum does not need to allocate
+var checksumStrings = func() (result [256]string) {
+	const hexDigits = "0123456789ABCDEF"
+	for i := range result {
+		result[i] = string([]byte{hexDigits[i>>4], hexDigits[i&0x0F]})
+	}
+	return result
+}()
+
+// defaultParserRegistry contains parsers registered with global RegisterParser/MustRegisterParser
+var defaultParserRegistry = newDefaultParserRegistry()
+
+func newDefaultParserRegistry() *ParserRegistry {
+	r := NewParserRegistry()
+	// for backwards compatibility support MTK. PMTK001 is correct an supported when using SentenceParser instance
+	_ = r.Register("", TypeMTK, newMTK)
+	return r
+}
 
 // defaultSentenceParser exists for backwards compatibility reasons to allow global Parse/RegisterParser/MustRegisterParser
 // to work as they did before SentenceParser was added.
 var defaultSentenceParser = SentenceParser{
-	CustomParsers: map[string]ParserFunc{
-		TypeMTK: newMTK, // for backwards compatibility support MTK. PMTK001 is correct an supported when using SentenceParser instance
-	},
+	Registry: defaultParserRegistry,
+}
+
+// DefaultParserRegistry returns the registry of parsers used by global Parse. Parsers registered with RegisterParser
+// and MustRegisterParser are added to this registry.
+func DefaultParserRegistry() *ParserRegistry {
+	return defaultParserRegistry
 }
 
 // MustRegisterParser register a custom parser or panic
@@ -241,223 +380,78 @@ func MustRegisterParser(sentenceType string, parser ParserFunc) {
 
 // RegisterParser register a custom parser
 func RegisterParser(sentenceType string, parser ParserFunc) error {
-	defaultSentenceParserMu.Lock()
-	defer defaultSentenceParserMu.Unlock()
-
-	if _, ok := defaultSentenceParser.CustomParsers[sentenceType]; ok {
-		return fmt.Errorf("nmea: parser for sentence type '%q' already exists", sentenceType)
-	}
-
-	defaultSentenceParser.CustomParsers[sentenceType] = parser
-	return nil
+	return defaultParserRegistry.Register("", sentenceType, parser)
 }
 
 // Parse parses the given string into the correct sentence type.
+// Parse is safe for concurrent use by multiple goroutines.
 func Parse(raw string) (Sentence, error) {
-	defaultSentenceParserMu.Lock()
-	defer defaultSentenceParserMu.Unlock()
-
 	return defaultSentenceParser.Parse(raw)
 }
 
 // Parse parses the given string into the correct sentence type.
-// This method is not co-routine safe!
 func (p *SentenceParser) Parse(raw string) (Sentence, error) {
 	s, err := p.parseBaseSentence(raw)
 	if err != nil {
 		return nil, err
 	}
+	if err := p.prepareBaseSentence(&s); err != nil {
+		return nil, err
+	}
+	return p.parseSentence(s)
+}
+
+// prepareBaseSentence applies parser options to base sentence and calls OnBaseSentence callback
+func (p *SentenceParser) prepareBaseSentence(s *BaseSentence) error {
+	s.Version, s.versionDetected = p.talkerVersion(s.Talker)
 
 	if p.OnBaseSentence != nil {
-		if err := p.OnBaseSentence(&s); err != nil {
-			return nil, err
+		return p.OnBaseSentence(s)
+	}
+	return nil
+}
+
+// parseSentence parses base sentence into the correct sentence type
+func (p *SentenceParser) parseSentence(s BaseSentence) (Sentence, error) {
+	parser, ok := p.lookupParser(s)
+	if !ok {
+		return nil, &NotSupportedError{Prefix: s.Prefix()}
+	}
+	result, err := parser(s)
+	if err == nil && p.VersionDetector != nil && p.Version == VersionUnknown {
+		if _, ok := p.TalkerVersions[s.Talker]; !ok {
+			p.VersionDetector.detect(result)
 		}
 	}
+	return result, p.fieldErrors(err)
+}
 
+// lookupParser returns parser of the base sentence
+func (p *SentenceParser) lookupParser(s BaseSentence) (ParserFunc, bool) {
 	// Custom parser allow overriding of existing parsers
 	if parser, ok := p.CustomParsers[s.Type]; ok {
-		return parser(s)
+		return parser, true
 	}
-
-	if s.Raw[0] == SentenceStart[0] {
-		switch s.Type {
-		case TypeRMC:
-			return newRMC(s)
-		case TypeAAM:
-			return newAAM(s)
-		case TypeACK:
-			return newACK(s)
-		case TypeACN:
-			return newACN(s)
-		case TypeALA:
-			return newALA(s)
-		case TypeALC:
-			return newALC(s)
-		case TypeALF:
-			return newALF(s)
-		case TypeALR:
-			return newALR(s)
-		case TypeAPB:
-			return newAPB(s)
-		case TypeARC:
-			return newARC(s)
-		case TypeBEC:
-			return newBEC(s)
-		case TypeBOD:
-			return newBOD(s)
-		case TypeBWC:
-			return newBWC(s)
-		case TypeBWR:
-			return newBWR(s)
-		case TypeBWW:
-			return newBWW(s)
-		case TypeDOR:
-			return newDOR(s)
-		case TypeDSC:
-			return newDSC(s)
-		case TypeDSE:
-			return newDSE(s)
-		case TypeDTM:
-			return newDTM(s)
-		case TypeEVE:
-			return newEVE(s)
-		case TypeFIR:
-			return newFIR(s)
-		case TypeGGA:
-			return newGGA(s)
-		case TypeGSA:
-			return newGSA(s)
-		case TypeGLL:
-			return newGLL(s)
-		case TypeVTG:
-			return newVTG(s)
-		case TypeZDA:
-			return newZDA(s)
-		case TypePGN:
-			return newPGN(s)
-		case TypePCDIN:
-			return newPCDIN(s)
-		case TypePGRME:
-			return newPGRME(s)
-		case TypePGRMT:
-			return newPGRMT(s)
-		case TypePHTRO:
-			return newPHTRO(s)
-		case TypePMTK001:
-			return newPMTK001(s)
-		case TypePRDID:
-			return newPRDID(s)
-		case TypePSKPDPT:
-			return newPSKPDPT(s)
-		case TypePSONCMS:
-			return newPSONCMS(s)
-		case TypeQuery:
-			return newQuery(s)
-		case TypeGSV:
-			return newGSV(s)
-		case TypeHBT:
-			return newHBT(s)
-		case TypeHDG:
-			return newHDG(s)
-		case TypeHDT:
-			return newHDT(s)
-		case TypeHDM:
-			return newHDM(s)
-		case TypeHSC:
-			return newHSC(s)
-		case TypeGNS:
-			return newGNS(s)
-		case TypeTHS:
-			return newTHS(s)
-		case TypeTLB:
-			return newTLB(s)
-		case TypeTLL:
-			return newTLL(s)
-		case TypeTTM:
-			return newTTM(s)
-		case TypeTXT:
-			return newTXT(s)
-		case TypeWPL:
-			return newWPL(s)
-		case TypeRMB:
-			return newRMB(s)
-		case TypeRPM:
-			return newRPM(s)
-		case TypeRSA:
-			return newRSA(s)
-		case TypeRSD:
-			return newRSD(s)
-		case TypeRTE:
-			return newRTE(s)
-		case TypeROT:
-			return newROT(s)
-		case TypeVBW:
-			return newVBW(s)
-		case TypeVDR:
-			return newVDR(s)
-		case TypeVHW:
-			return newVHW(s)
-		case TypeVSD:
-			return newVSD(s)
-		case TypeVPW:
-			return newVPW(s)
-		case TypeVLW:
-			return newVLW(s)
-		case TypeVWR:
-			return newVWR(s)
-		case TypeVWT:
-			return newVWT(s)
-		case TypeDPT:
-			return newDPT(s)
-		case TypeDBT:
-			return newDBT(s)
-		case TypeDBK:
-			return newDBK(s)
-		case TypeDBS:
-			return newDBS(s)
-		case TypeMDA:
-			return newMDA(s)
-		case TypeMTA:
-			return newMTA(s)
-		case TypeMTW:
-			return newMTW(s)
-		case TypeMWD:
-			return newMWD(s)
-		case TypeMWV:
-			return newMWV(s)
-		case TypeOSD:
-			return newOSD(s)
-		case TypeXDR:
-			return newXDR(s)
-		case TypeXTE:
-			return newXTE(s)
-		case TypePKLID:
-			return newPKLID(s)
-		case TypePKNID:
-			return newPKNID(s)
-		case TypePKLSH:
-			return newPKLSH(s)
-		case TypePKNSH:
-			return newPKNSH(s)
-		case TypePKLDS:
-			return newPKLDS(s)
-		case TypePKNDS:
-			return newPKNDS(s)
-		case TypePKWDWPL:
-			return newPKWDWPL(s)
+	if p.Registry != nil {
+		if parser, ok := p.Registry.Lookup(s.Talker, s.Type); ok {
+			return parser, true
 		}
 	}
-	if s.Raw[0] == SentenceStartEncapsulated[0] {
-		switch s.Type {
-		case TypeABM:
-			return newABM(s)
-		case TypeBBM:
-			return newBBM(s)
-		case TypeTTD:
-			return newTTD(s)
-		case TypeVDM, TypeVDO:
-			return newVDMVDO(s)
-		}
+
+	metadata := p.Metadata
+	if metadata == nil {
+		metadata = defaultMetadataRegistry
+	}
+	if info, ok := metadata.Lookup(s.Type); ok && info.Encapsulated == (s.Raw[0] == SentenceStartEncapsulated[0]) {
+		return info.Parser, true
+	}
+	return nil, false
+}
+
+// fieldErrors returns only the first of collected field errors when not in lenient mode
+func (p *SentenceParser) fieldErrors(err error) error {
+	if errs, ok := err.(FieldErrors); ok && !p.Lenient && len(errs) > 0 {
+		return errs[0]
 	}
-	return nil, &NotSupportedError{Prefix: s.Prefix()}
+	return err
 }
@@ -156,6 +156,30 @@ func TestSentences(t *testing.T) {
 			raw:  "\\\\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl`K6`nV00Sv,0*52",
 			err:  "nmea: tagblock does not contain checksum separator",
 		},
+		{
+			name:     "escaped characters are decoded",
+			raw:      "$GPTXT,01,01,02,Hello^2C world ^5E^5e*62",
+			datatype: "TXT",
+			talkerid: "GP",
+			prefix:   "GPTXT",
+			sent: BaseSentence{
+				Talker:   "GP",
+				Type:     "TXT",
+				Fields:   []string{"01", "01", "02", "Hello, world ^^"},
+				Checksum: "62",
+				Raw:      "$GPTXT,01,01,02,Hello^2C world ^5E^5e*62",
+			},
+		},
+		{
+			name: "truncated escape sequence",
+			raw:  "$GPFOO,a^2Cb,^2*11",
+			err:  `nmea: GPFOO invalid escape sequence in field 1: "^2"`,
+		},
+		{
+			name: "invalid escape sequence",
+			raw:  "$GPFOO,a^G1*34",
+			err:  `nmea: GPFOO invalid escape sequence in field 0: "^G1"`,
+		},
 	}
 
 	for _, tt := range sentencetests {
@@ -468,6 +492,48 @@ func TestSentenceParser_Parse(t *testing.T) {
 			expected:      nil,
 			expectedError: "nmea: can not parse empty input",
 		},
+		{
+			name:      "ok, escaped fields are decoded",
+			whenInput: "$RAALR,220516,001,A,A,Temp ^3E 50^B0C*3D",
+			expected: ALR{
+				BaseSentence: BaseSentence{
+					Talker:   "RA",
+					Type:     "ALR",
+					Fields:   []string{"220516", "001", "A", "A", "Temp > 50\xb0C"},
+					Checksum: "3D",
+					Raw:      "$RAALR,220516,001,A,A,Temp ^3E 50^B0C*3D",
+				},
+				Time:            Time{Valid: true, Hour: 22, Minute: 5, Second: 16},
+				AlarmIdentifier: 1,
+				Condition:       "A",
+				State:           "A",
+				Description:     "Temp > 50\xb0C",
+			},
+		},
+		{
+			name:        "ok, keep escaped fields",
+			givenParser: &SentenceParser{KeepEscapedFields: true},
+			whenInput:   "$GPTXT,01,01,02,a^2Cb*61",
+			expected: TXT{
+				BaseSentence: BaseSentence{
+					Talker:   "GP",
+					Type:     "TXT",
+					Fields:   []string{"01", "01", "02", "a^2Cb"},
+					Checksum: "61",
+					Raw:      "$GPTXT,01,01,02,a^2Cb*61",
+				},
+				TotalNumber: 1,
+				Number:      1,
+				ID:          2,
+				Message:     "a^2Cb",
+			},
+		},
+		{
+			name:          "nok, invalid escape sequence",
+			whenInput:     "$GPFOO,a^G1*34",
+			expected:      nil,
+			expectedError: `nmea: GPFOO invalid escape sequence in field 0: "^G1"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -677,3 +743,106 @@ func TestSentenceParser_OnBaseSentence(t *testing.T) {
 		})
 	}
 }
+
+func TestSentenceParser_Lenient(t *testing.T) {
+	var testCases = []struct {
+		name          string
+		whenInput     string
+		expected      Sentence
+		expectedError string
+		expectInvalid []int
+	}{
+		{
+			name:      "ok, invalid HDOP does not discard position",
+			whenInput: "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,x.7,-25.0,M,21.0,M,,0000*10",
+			expected: GGA{
+				BaseSentence: BaseSentence{
+					Talker:   "GP",
+					Type:     "GGA",
+					Fields:   []string{"034225.077", "3356.4650", "S", "15124.5567", "E", "1", "03", "x.7", "-25.0", "M", "21.0", "M", "", "0000"},
+					Checksum: "10",
+					Raw:      "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,x.7,-25.0,M,21.0,M,,0000*10",
+				},
+				Time:          Time{Valid: true, Hour: 3, Minute: 42, Second: 25, Millisecond: 77},
+				Latitude:      MustParseLatLong("3356.4650 S"),
+				Longitude:     MustParseLatLong("15124.5567 E"),
+				FixQuality:    GPS,
+				NumSatellites: 3,
+				HDOP:          0,
+				Altitude:      -25.0,
+				Separation:    21.0,
+				DGPSAge:       "",
+				DGPSId:        "0000",
+			},
+			expectedError: "nmea: GPGGA invalid hdop: x.7",
+			expectInvalid: []int{7},
+		},
+		{
+			name:      "ok, all invalid fields are reported",
+			whenInput: "$GPRMC,2205xx,A,5133.82,N,00042.24,W,173.8,abc,130694,004.2,W*31",
+			expected: RMC{
+				BaseSentence: BaseSentence{
+					Talker:   "GP",
+					Type:     "RMC",
+					Fields:   []string{"2205xx", "A", "5133.82", "N", "00042.24", "W", "173.8", "abc", "130694", "004.2", "W"},
+					Checksum: "31",
+					Raw:      "$GPRMC,2205xx,A,5133.82,N,00042.24,W,173.8,abc,130694,004.2,W*31",
+				},
+				Time:      Time{},
+				Validity:  "A",
+				Latitude:  MustParseGPS("5133.82 N"),
+				Longitude: MustParseGPS("00042.24 W"),
+				Speed:     173.8,
+				Course:    0,
+				Date:      Date{Valid: true, DD: 13, MM: 6, YY: 94},
+				Variation: -4.2,
+			},
+			expectedError: "nmea: GPRMC invalid time: 2205xx; nmea: GPRMC invalid course: abc",
+			expectInvalid: []int{0, 7},
+		},
+		{
+			name:      "ok, valid sentence",
+			whenInput: "$HEROT,-11.23,A*07",
+			expected: ROT{
+				BaseSentence: BaseSentence{
+					Talker:   "HE",
+					Type:     "ROT",
+					Fields:   []string{"-11.23", "A"},
+					Checksum: "07",
+					Raw:      "$HEROT,-11.23,A*07",
+				},
+				RateOfTurn: -11.23,
+				Valid:      true,
+			},
+		},
+		{
+			name:          "nok, checksum errors are not ignored",
+			whenInput:     "$HEROT,-11.23,A*FF",
+			expectedError: "nmea: sentence checksum mismatch [07 != FF]",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := SentenceParser{Lenient: true}
+
+			result, err := p.Parse(tc.whenInput)
+
+			assert.Equal(t, tc.expected, result)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.expectInvalid != nil {
+				var fieldErrs FieldErrors
+				assert.True(t, errors.As(err, &fieldErrs))
+				assert.Equal(t, tc.expectInvalid, fieldErrs.Indices())
+
+				// strict mode returns the same sentence with the first field error
+				strict, err := Parse(tc.whenInput)
+				assert.Equal(t, result, strict)
+				assert.Equal(t, fieldErrs[0], err)
+			}
+		})
+	}
+}
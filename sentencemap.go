@@ -0,0 +1,182 @@
+package nmea
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// BaseSentenceKey is the key of BaseSentence metadata (talker, type, checksum, raw sentence and tag block) in map
+// returned by ToMap
+const BaseSentenceKey = "sentence"
+
+// dateCenturyReference is the fixed reference time used to resolve century of dates in ToMap and JSON, so that the
+// encoding does not depend on current time. It is the middle of years 1980-2079 (GPS epoch is in 1980), so two-digit
+// years from 80 are in 20th century and years before 80 are in 21st century.
+var dateCenturyReference = time.Date(2029, 7, 1, 0, 0, 0, 0, time.UTC)
+
+// ToMap converts sentence into map of field names to values. Field names are struct field names in snake_case
+// (NumSatellites becomes num_satellites). Embedded BaseSentence is stored under BaseSentenceKey (talker, type,
+// checksum, raw, tag_block and version when set). Values are converted:
+//   - Time is ISO 8601 time string (hh:mm:ss.sss), Date is ISO 8601 date string (yyyy-mm-dd, two-digit years
+//     before 80 are in 21st century), nil when not valid
+//   - Float64 and Int64 are float64 and int64, nil when not valid
+//   - nested structs are maps and slices are []interface{}
+//
+// ToMap works with any struct sentence, including sentences of custom parsers.
+func ToMap(s Sentence) map[string]interface{} {
+	v := reflect.ValueOf(s)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Type() == baseSentenceType {
+		return map[string]interface{}{BaseSentenceKey: baseSentenceToMap(v.Interface().(BaseSentence))}
+	}
+	if v.Kind() != reflect.Struct {
+		return map[string]interface{}{BaseSentenceKey: map[string]interface{}{
+			"talker": s.TalkerID(),
+			"type":   s.DataType(),
+		}}
+	}
+	result := map[string]interface{}{}
+	structToMap(v, result)
+	return result
+}
+
+func structToMap(v reflect.Value, dst map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue // unexported
+		}
+		fv := v.Field(i)
+		if f.Anonymous {
+			if f.Type == baseSentenceType {
+				dst[BaseSentenceKey] = baseSentenceToMap(fv.Interface().(BaseSentence))
+				continue
+			}
+			if fv.Kind() == reflect.Struct {
+				structToMap(fv, dst)
+				continue
+			}
+			if f.PkgPath != "" {
+				continue
+			}
+		}
+		dst[snakeCase(f.Name)] = valueToInterface(fv)
+	}
+}
+
+func baseSentenceToMap(s BaseSentence) map[string]interface{} {
+	result := map[string]interface{}{
+		"talker":    s.Talker,
+		"type":      s.Type,
+		"checksum":  s.Checksum,
+		"raw":       s.Raw,
+		"tag_block": nil,
+	}
+	if s.Version != VersionUnknown {
+		result["version"] = s.Version.String()
+	}
+	if s.TagBlock != (TagBlock{}) {
+		tagBlock := valueToInterface(reflect.ValueOf(s.TagBlock)).(map[string]interface{})
+		tagBlock["extra"] = valueToInterface(reflect.ValueOf(s.TagBlock.Extra()))
+		result["tag_block"] = tagBlock
+	}
+	return result
+}
+
+func valueToInterface(v reflect.Value) interface{} {
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(Time)
+		if !t.Valid {
+			return nil
+		}
+		return formatISOTime(t)
+	case dateType:
+		d := v.Interface().(Date)
+		if !d.Valid {
+			return nil
+		}
+		return formatISODate(d)
+	case float64Type:
+		f := v.Interface().(Float64)
+		if !f.Valid || !isFinite(f.Value) {
+			return nil
+		}
+		return f.Value
+	case int64Type:
+		i := v.Interface().(Int64)
+		if !i.Valid {
+			return nil
+		}
+		return i.Value
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return valueToInterface(v.Elem())
+	case reflect.Struct:
+		m := map[string]interface{}{}
+		structToMap(v, m)
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Bytes()
+		}
+		fallthrough
+	case reflect.Array:
+		result := make([]interface{}, v.Len())
+		for i := range result {
+			result[i] = valueToInterface(v.Index(i))
+		}
+		return result
+	}
+	return v.Interface()
+}
+
+// formatISOTime formats time as ISO 8601 time (hh:mm:ss.sss)
+func formatISOTime(t Time) string {
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", t.Hour, t.Minute, t.Second, t.Millisecond)
+}
+
+// formatISODate formats date as ISO 8601 date (yyyy-mm-dd)
+func formatISODate(d Date) string {
+	// year is resolved with the first day of the year, as invalid day or month would move the date to other year
+	year := closestCentury(dateCenturyReference, Date{Valid: true, DD: 1, MM: 1, YY: d.YY}, Time{}).Year()
+	return fmt.Sprintf("%04d-%02d-%02d", year, d.MM, d.DD)
+}
+
+// snakeCase converts Go identifier to snake_case. Acronyms are kept together, for example NumSatellites becomes
+// num_satellites, DGPSId becomes dgps_id and NumberSVsInView becomes number_svs_in_view.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if nextIsLower && runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2])) {
+				nextIsLower = false // plural of acronym, e.g. SVs
+			}
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,188 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMap(t *testing.T) {
+	s, err := Parse("$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70")
+	assert.NoError(t, err)
+
+	expected := map[string]interface{}{
+		BaseSentenceKey: map[string]interface{}{
+			"talker":    "GP",
+			"type":      "RMC",
+			"checksum":  "70",
+			"raw":       "$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70",
+			"tag_block": nil,
+		},
+		"time":       "22:05:16.000",
+		"validity":   "A",
+		"latitude":   MustParseGPS("5133.82 N"),
+		"longitude":  MustParseGPS("00042.24 W"),
+		"speed":      173.8,
+		"course":     231.8,
+		"date":       "1994-06-13",
+		"variation":  -4.2,
+		"ffa_mode":   "",
+		"nav_status": "",
+	}
+	assert.Equal(t, expected, ToMap(s))
+}
+
+func TestToMap_NestedAndTagBlock(t *testing.T) {
+	s, err := Parse(`\s:Satelite_1,c:1553390539*62\$GLGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*6B`)
+	assert.NoError(t, err)
+
+	m := ToMap(s)
+	base := m[BaseSentenceKey].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"time":          int64(1553390539),
+		"relative_time": int64(0),
+		"destination":   "",
+		"grouping":      "",
+		"line_count":    int64(0),
+		"source":        "Satelite_1",
+		"text":          "",
+		"extra":         nil,
+	}, base["tag_block"])
+
+	assert.Equal(t, int64(11), m["number_svs_in_view"])
+	info := m["info"].([]interface{})
+	assert.Len(t, info, 4)
+	assert.Equal(t, map[string]interface{}{"svprn_number": int64(3), "elevation": int64(3), "azimuth": int64(111), "snr": int64(0)}, info[0])
+}
+
+type toMapTestSentence struct {
+	BaseSentence
+	Depth     Float64
+	Count     Int64
+	Time      Time
+	Date      Date
+	Payload   []byte
+	Status    *string
+	ChannelA1 string
+	private   string
+}
+
+func TestToMap_NullableAndCustom(t *testing.T) {
+	status := "A"
+	var testCases = []struct {
+		name   string
+		when   Sentence
+		expect map[string]interface{}
+	}{
+		{
+			name: "ok, invalid values are nil",
+			when: toMapTestSentence{BaseSentence: BaseSentence{Talker: "AA", Type: "XYZ"}},
+			expect: map[string]interface{}{
+				BaseSentenceKey: map[string]interface{}{"talker": "AA", "type": "XYZ", "checksum": "", "raw": "", "tag_block": nil},
+				"depth":         nil,
+				"count":         nil,
+				"time":          nil,
+				"date":          nil,
+				"payload":       nil,
+				"status":        nil,
+				"channel_a1":    "",
+			},
+		},
+		{
+			name: "ok, valid values",
+			when: &toMapTestSentence{
+				BaseSentence: BaseSentence{Talker: "AA", Type: "XYZ"},
+				Depth:        Float64{Value: 1.5, Valid: true},
+				Count:        Int64{Value: 0, Valid: true},
+				Time:         Time{Valid: true, Hour: 1, Minute: 2, Second: 3, Millisecond: 40},
+				Date:         Date{Valid: true, DD: 31, MM: 12, YY: 99},
+				Payload:      []byte{1, 2},
+				Status:       &status,
+				ChannelA1:    "B",
+				private:      "x",
+			},
+			expect: map[string]interface{}{
+				BaseSentenceKey: map[string]interface{}{"talker": "AA", "type": "XYZ", "checksum": "", "raw": "", "tag_block": nil},
+				"depth":         1.5,
+				"count":         int64(0),
+				"time":          "01:02:03.040",
+				"date":          "1999-12-31",
+				"payload":       []byte{1, 2},
+				"status":        "A",
+				"channel_a1":    "B",
+			},
+		},
+		{
+			name: "ok, struct parser sentence",
+			when: testStructXYZ{
+				BaseSentence: BaseSentence{Talker: "AA", Type: "XYZ"},
+				Speed:        12.5,
+				Count:        Int64{Value: 3, Valid: true},
+				Level:        -1,
+			},
+			expect: map[string]interface{}{
+				BaseSentenceKey: map[string]interface{}{"talker": "AA", "type": "XYZ", "checksum": "", "raw": "", "tag_block": nil},
+				"speed":         12.5,
+				"latitude":      float64(0),
+				"longitude":     float64(0),
+				"status":        "",
+				"time":          nil,
+				"count":         int64(3),
+				"level":         int8(-1),
+				"comment":       "",
+			},
+		},
+		{
+			name: "ok, base sentence",
+			when: BaseSentence{Talker: "AA", Type: "XYZ", Raw: "$AAXYZ*hh"},
+			expect: map[string]interface{}{
+				BaseSentenceKey: map[string]interface{}{"talker": "AA", "type": "XYZ", "checksum": "", "raw": "$AAXYZ*hh", "tag_block": nil},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, ToMap(tc.when))
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	var testCases = []struct {
+		when   string
+		expect string
+	}{
+		{when: "NumSatellites", expect: "num_satellites"},
+		{when: "HDOP", expect: "hdop"},
+		{when: "DGPSId", expect: "dgps_id"},
+		{when: "SystemID", expect: "system_id"},
+		{when: "VDLMessageNumber", expect: "vdl_message_number"},
+		{when: "NumberSVsInView", expect: "number_svs_in_view"},
+		{when: "SVs", expect: "svs"},
+		{when: "Q0", expect: "q0"},
+		{when: "Origin1Range", expect: "origin1_range"},
+		{when: "time", expect: "time"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.when, func(t *testing.T) {
+			assert.Equal(t, tc.expect, snakeCase(tc.when))
+		})
+	}
+}
+
+func TestFormatISODate(t *testing.T) {
+	assert.Equal(t, "2079-01-02", formatISODate(Date{Valid: true, DD: 2, MM: 1, YY: 79}))
+	assert.Equal(t, "1980-01-06", formatISODate(Date{Valid: true, DD: 6, MM: 1, YY: 80}))
+	assert.Equal(t, "1980-01-01", formatISODate(Date{Valid: true, DD: 1, MM: 1, YY: 80}))
+	assert.Equal(t, "2079-12-31", formatISODate(Date{Valid: true, DD: 31, MM: 12, YY: 79}))
+	assert.Equal(t, "2000-02-29", formatISODate(Date{Valid: true, DD: 29, MM: 2, YY: 0}))
+	assert.Equal(t, "1999-12-31", formatISODate(Date{Valid: true, DD: 31, MM: 12, YY: 99}))
+
+	// same century as ResolveDateTime with reference time in the middle of the years
+	for yy := 0; yy < 100; yy++ {
+		d := Date{Valid: true, DD: 1, MM: 7, YY: yy}
+		ts, err := ResolveDateTime(dateCenturyReference, d, Time{Valid: true})
+		assert.NoError(t, err)
+		assert.Equal(t, ts.Format("2006-01-02"), formatISODate(d))
+	}
+}
@@ -0,0 +1,379 @@
+package nmea
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// StructTag is the struct field tag key used by StructParser to map sentence fields to struct fields.
+//
+// Tag value format is `<index>[-<index>],<kind>[=<options>]` where index is the (zero based) index of the sentence
+// data field. Supported kinds are:
+//
+//   - `string` - field value as is. Go type string.
+//   - `enum=A|V` - one of the given values or empty string. Go type string.
+//   - `int` - integer. Go types int, int8, int16, int32, int64 or Int64 (empty field is invalid Int64).
+//   - `float[=<decimals>]` - decimal number. Go types float32, float64 or Float64 (empty field is invalid Float64).
+//     New values are encoded with at most the given number of decimal places, 6 by default.
+//   - `time` - time in hhmmss.ss format. Go type Time.
+//   - `date` - date in ddmmyy format. Go type Date.
+//   - `latlong` - coordinate and its direction (N/S/E/W) from two consecutive fields (for example `2-3,latlong`).
+//     Go type float64. Coordinate is encoded as latitude or longitude depending on the direction of the parsed
+//     sentence; for new sentences struct field name starting with "Lon" or "Lng" means longitude.
+//
+// Struct fields without tag or with tag `nmea:"-"` are ignored.
+const StructTag = "nmea"
+
+// Kinds of struct tags
+const (
+	structKindString  = "string"
+	structKindEnum    = "enum"
+	structKindInt     = "int"
+	structKindFloat   = "float"
+	structKindTime    = "time"
+	structKindDate    = "date"
+	structKindLatLong = "latlong"
+
+	// structFloatDecimals is the default number of decimal places of encoded `float` fields
+	structFloatDecimals = 6
+)
+
+var (
+	baseSentenceType = reflect.TypeOf(BaseSentence{})
+	int64Type        = reflect.TypeOf(Int64{})
+	float64Type      = reflect.TypeOf(Float64{})
+	timeType         = reflect.TypeOf(Time{})
+	dateType         = reflect.TypeOf(Date{})
+)
+
+// structCodecs contains codecs of the struct types passed to StructParser, used by Encode
+var structCodecs sync.Map // map[reflect.Type]*structCodec
+
+// structCodec parses and encodes sentences of a struct type declared with StructTag tags
+type structCodec struct {
+	sentenceType string
+	typ          reflect.Type
+	pointer      bool
+	base         int
+	fields       []structField
+}
+
+// structField is a struct field mapped to one (or two for coordinates) sentence fields
+type structField struct {
+	index     int
+	name      string
+	context   string
+	from      int
+	to        int
+	kind      string
+	options   []string
+	null      bool
+	longitude bool
+	decimals  int
+}
+
+// StructParser creates ParserFunc for given sentence type from a struct with StructTag field tags. The struct must
+// embed BaseSentence. Parsed sentence values have the same type as v (struct or pointer to struct).
+//
+// Struct types passed to StructParser are also encoded by Encode and EncodeWithTagBlock using the same field tags.
+//
+// Example:
+//
+//	type XYZ struct {
+//		nmea.BaseSentence
+//		Speed     float64 `nmea:"0,float"`
+//		Latitude  float64 `nmea:"2-3,latlong"`
+//		Status    string  `nmea:"4,enum=A|V"`
+//		Time      nmea.Time `nmea:"5,time"`
+//	}
+//
+//	parser, err := nmea.StructParser("XYZ", XYZ{})
+func StructParser(sentenceType string, v Sentence) (ParserFunc, error) {
+	c, err := newStructCodec(sentenceType, v)
+	if err != nil {
+		return nil, err
+	}
+	structCodecs.Store(c.typ, c)
+	return c.parse, nil
+}
+
+// RegisterStructParser registers parser created by StructParser for the sentence type with the global parser
+// registry (see RegisterParser).
+func RegisterStructParser(sentenceType string, v Sentence) error {
+	parser, err := StructParser(sentenceType, v)
+	if err != nil {
+		return err
+	}
+	return RegisterParser(sentenceType, parser)
+}
+
+// MustRegisterStructParser registers struct parser or panics
+func MustRegisterStructParser(sentenceType string, v Sentence) {
+	if err := RegisterStructParser(sentenceType, v); err != nil {
+		panic(err)
+	}
+}
+
+func newStructCodec(sentenceType string, v Sentence) (*structCodec, error) {
+	if v == nil {
+		return nil, fmt.Errorf("nmea: struct parser for sentence type '%q' needs a struct value", sentenceType)
+	}
+	c := &structCodec{sentenceType: sentenceType, typ: reflect.TypeOf(v), base: -1}
+	if c.typ.Kind() == reflect.Ptr {
+		c.pointer = true
+		c.typ = c.typ.Elem()
+	}
+	if c.typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nmea: struct parser type %s is not a struct", c.typ)
+	}
+
+	used := map[int]string{}
+	for i := 0; i < c.typ.NumField(); i++ {
+		sf := c.typ.Field(i)
+		if sf.Anonymous && sf.Type == baseSentenceType {
+			c.base = i
+			continue
+		}
+		tag, ok := sf.Tag.Lookup(StructTag)
+		if !ok || tag == "-" {
+			continue
+		}
+		if sf.PkgPath != "" {
+			return nil, fmt.Errorf("nmea: struct field %s.%s with tag is not exported", c.typ.Name(), sf.Name)
+		}
+		f, err := parseStructTag(sf, tag)
+		if err != nil {
+			return nil, fmt.Errorf("nmea: struct field %s.%s: %v", c.typ.Name(), sf.Name, err)
+		}
+		f.index = i
+		for idx := f.from; idx <= f.to; idx++ {
+			if other, ok := used[idx]; ok {
+				return nil, fmt.Errorf("nmea: struct field %s.%s: sentence field %d is already used by %s",
+					c.typ.Name(), sf.Name, idx, other)
+			}
+			used[idx] = sf.Name
+		}
+		c.fields = append(c.fields, f)
+	}
+	if c.base == -1 {
+		return nil, fmt.Errorf("nmea: struct parser type %s does not embed BaseSentence", c.typ)
+	}
+	sort.Slice(c.fields, func(i, j int) bool {
+		return c.fields[i].from < c.fields[j].from
+	})
+	return c, nil
+}
+
+// parseStructTag parses tag of struct field and checks that the kind matches the field type
+func parseStructTag(sf reflect.StructField, tag string) (structField, error) {
+	f := structField{name: sf.Name, context: fieldContext(sf.Name)}
+	parts := strings.Split(tag, ",")
+	if len(parts) != 2 {
+		return f, fmt.Errorf("tag %q should be in <index>,<kind> format", tag)
+	}
+
+	indexes := strings.SplitN(parts[0], "-", 2)
+	var err error
+	if f.from, err = strconv.Atoi(indexes[0]); err != nil || f.from < 0 {
+		return f, fmt.Errorf("invalid field index %q", parts[0])
+	}
+	f.to = f.from
+	if len(indexes) == 2 {
+		if f.to, err = strconv.Atoi(indexes[1]); err != nil || f.to < f.from {
+			return f, fmt.Errorf("invalid field index %q", parts[0])
+		}
+	}
+
+	kind := strings.SplitN(parts[1], "=", 2)
+	f.kind = kind[0]
+	if len(kind) == 2 {
+		f.options = strings.Split(kind[1], "|")
+	}
+	if f.kind == structKindEnum && len(f.options) == 0 {
+		return f, fmt.Errorf("enum %q has no options", parts[1])
+	}
+	f.decimals = structFloatDecimals
+	if f.kind == structKindFloat && len(f.options) != 0 {
+		if f.decimals, err = strconv.Atoi(kind[1]); err != nil || f.decimals < 0 {
+			return f, fmt.Errorf("invalid number of decimal places %q", parts[1])
+		}
+		f.options = nil
+	}
+	if f.kind != structKindEnum && len(f.options) != 0 {
+		return f, fmt.Errorf("kind %q does not have options", f.kind)
+	}
+	if (f.kind == structKindLatLong) != (f.to != f.from) {
+		if f.kind == structKindLatLong {
+			return f, fmt.Errorf("latlong needs two consecutive fields")
+		}
+		return f, fmt.Errorf("kind %q uses a single field", f.kind)
+	}
+	if f.kind == structKindLatLong && f.to != f.from+1 {
+		return f, fmt.Errorf("latlong needs two consecutive fields")
+	}
+
+	ok := false
+	t := sf.Type
+	switch f.kind {
+	case structKindString, structKindEnum:
+		ok = t.Kind() == reflect.String
+	case structKindInt:
+		f.null = t == int64Type
+		ok = f.null || (t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64)
+	case structKindFloat:
+		f.null = t == float64Type
+		ok = f.null || t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+	case structKindTime:
+		ok = t == timeType
+	case structKindDate:
+		ok = t == dateType
+	case structKindLatLong:
+		ok = t.Kind() == reflect.Float64
+		name := strings.ToLower(sf.Name)
+		f.longitude = strings.HasPrefix(name, "lon") || strings.HasPrefix(name, "lng")
+	default:
+		return f, fmt.Errorf("unknown kind %q", f.kind)
+	}
+	if !ok {
+		return f, fmt.Errorf("kind %q can not be used with type %s", f.kind, t)
+	}
+	return f, nil
+}
+
+// fieldContext creates error context from struct field name, for example "FixQuality" becomes "fix quality"
+func fieldContext(name string) string {
+	var sb strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			sb.WriteRune(' ')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// parse parses base sentence into new value of the struct type
+func (c *structCodec) parse(s BaseSentence) (Sentence, error) {
+	p := newParser(s)
+	p.AssertType(c.sentenceType)
+
+	ptr := reflect.New(c.typ)
+	v := ptr.Elem()
+	v.Field(c.base).Set(reflect.ValueOf(s))
+	for _, f := range c.fields {
+		fv := v.Field(f.index)
+		switch f.kind {
+		case structKindString:
+			fv.SetString(p.String(f.from, f.context))
+		case structKindEnum:
+			fv.SetString(p.EnumString(f.from, f.context, f.options...))
+		case structKindInt:
+			n := p.NullInt64(f.from, f.context)
+			if f.null {
+				fv.Set(reflect.ValueOf(n))
+			} else if fv.OverflowInt(n.Value) {
+				p.setFieldErr(&FieldError{Index: f.from, Context: f.context, Value: p.Fields[f.from], Reason: "value out of range"})
+			} else {
+				fv.SetInt(n.Value)
+			}
+		case structKindFloat:
+			n := p.NullFloat64(f.from, f.context)
+			if f.null {
+				fv.Set(reflect.ValueOf(n))
+			} else {
+				fv.SetFloat(n.Value)
+			}
+		case structKindTime:
+			fv.Set(reflect.ValueOf(p.Time(f.from, f.context)))
+		case structKindDate:
+			fv.Set(reflect.ValueOf(p.Date(f.from, f.context)))
+		case structKindLatLong:
+			fv.SetFloat(p.LatLong(f.from, f.to, f.context))
+		}
+	}
+
+	if c.pointer {
+		return ptr.Interface().(Sentence), p.Err()
+	}
+	return v.Interface().(Sentence), p.Err()
+}
+
+// lookupStructCodec returns codec of the sentence when its type was declared with StructParser
+func lookupStructCodec(s Sentence) (*structCodec, reflect.Value, bool) {
+	v := reflect.ValueOf(s)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	c, ok := structCodecs.Load(v.Type())
+	if !ok {
+		return nil, reflect.Value{}, false
+	}
+	return c.(*structCodec), v, true
+}
+
+// encodeFields encodes struct fields of the sentence in wire format. Sentence fields that are not mapped to struct
+// fields keep their original values.
+func (c *structCodec) encodeFields(v reflect.Value) ([]string, error) {
+	base := v.Field(c.base).Interface().(BaseSentence)
+	e := NewFieldEncoder(base)
+	for _, f := range c.fields {
+		for len(e.fields) < f.from {
+			e.Fixed("")
+		}
+		fv := v.Field(f.index)
+		switch f.kind {
+		case structKindString:
+			e.String(fv.String())
+		case structKindEnum:
+			if s := fv.String(); s != "" && !containsString(f.options, s) {
+				e.setFieldErr(&FieldError{Index: f.from, Context: f.context, Value: s})
+			}
+			e.String(fv.String())
+		case structKindInt:
+			if f.null {
+				e.NullInt64(fv.Interface().(Int64))
+			} else {
+				e.Int64(fv.Int())
+			}
+		case structKindFloat:
+			if f.null {
+				e.NullFloat64(fv.Interface().(Float64), f.decimals)
+			} else {
+				e.Float64(fv.Float(), f.decimals)
+			}
+		case structKindTime:
+			e.Time(fv.Interface().(Time), f.context)
+		case structKindDate:
+			e.Date(fv.Interface().(Date), f.context)
+		case structKindLatLong:
+			longitude := f.longitude
+			if dir, ok := e.originalField(1); ok && (dir == North || dir == South || dir == East || dir == West) {
+				longitude = dir == East || dir == West
+			}
+			if longitude {
+				e.Longitude(fv.Float(), f.context)
+			} else {
+				e.Latitude(fv.Float(), f.context)
+			}
+		}
+	}
+	return e.Fields()
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
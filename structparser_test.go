@@ -0,0 +1,226 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStructXYZ struct {
+	BaseSentence
+	Speed     float64 `nmea:"0,float"`
+	Latitude  float64 `nmea:"2-3,latlong"`
+	Longitude float64 `nmea:"4-5,latlong"`
+	Status    string  `nmea:"6,enum=A|V"`
+	Time      Time    `nmea:"7,time"`
+	Count     Int64   `nmea:"8,int"`
+	Level     int8    `nmea:"9,int"`
+	Comment   string
+}
+
+func TestStructParser(t *testing.T) {
+	var tests = []struct {
+		name string
+		raw  string
+		err  string
+		msg  testStructXYZ
+	}{
+		{
+			name: "good sentence",
+			raw:  "$AAXYZ,12.5,K,5130.5000,N,00007.5000,W,A,123519.50,,*46",
+			msg: testStructXYZ{
+				Speed:     12.5,
+				Latitude:  MustParseLatLong("5130.5000 N"),
+				Longitude: MustParseLatLong("00007.5000 W"),
+				Status:    "A",
+				Time:      Time{Valid: true, Hour: 12, Minute: 35, Second: 19, Millisecond: 500},
+				Count:     Int64{},
+				Level:     0,
+			},
+		},
+		{
+			name: "invalid enum",
+			raw:  "$AAXYZ,12.5,K,5130.5000,N,00007.5000,W,X,123519.50,7,*68",
+			err:  "nmea: AAXYZ invalid status: X",
+		},
+		{
+			name: "invalid float",
+			raw:  "$AAXYZ,abc,K,5130.5000,N,00007.5000,W,A,123519.50,7,*09",
+			err:  "nmea: AAXYZ invalid speed: abc",
+		},
+		{
+			name: "int out of range",
+			raw:  "$AAXYZ,12.5,K,5130.5000,N,00007.5000,W,A,123519.50,7,300*42",
+			err:  "nmea: AAXYZ invalid level: value out of range",
+		},
+	}
+	parser, err := StructParser("XYZ", testStructXYZ{})
+	assert.NoError(t, err)
+	p := SentenceParser{CustomParsers: map[string]ParserFunc{"XYZ": parser}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := p.Parse(tt.raw)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			xyz := m.(testStructXYZ)
+			xyz.BaseSentence = BaseSentence{}
+			assert.Equal(t, tt.msg, xyz)
+		})
+	}
+}
+
+func TestStructParser_Pointer(t *testing.T) {
+	parser, err := StructParser("XYZ", &testStructXYZ{})
+	assert.NoError(t, err)
+	p := SentenceParser{CustomParsers: map[string]ParserFunc{"XYZ": parser}}
+
+	m, err := p.Parse("$AAXYZ,12.5,K,5130.5000,N,00007.5000,W,A,123519.50,7,*71")
+	assert.NoError(t, err)
+	xyz, ok := m.(*testStructXYZ)
+	assert.True(t, ok)
+	assert.Equal(t, Int64{Value: 7, Valid: true}, xyz.Count)
+}
+
+func TestStructParser_Lenient(t *testing.T) {
+	parser, err := StructParser("XYZ", testStructXYZ{})
+	assert.NoError(t, err)
+	p := SentenceParser{CustomParsers: map[string]ParserFunc{"XYZ": parser}, Lenient: true}
+
+	m, err := p.Parse("$AAXYZ,abc,K,5130.5000,N,00007.5000,W,X,123519.50,7,*10")
+	assert.EqualError(t, err, "nmea: AAXYZ invalid speed: abc; nmea: AAXYZ invalid status: X")
+	assert.Equal(t, Int64{Value: 7, Valid: true}, m.(testStructXYZ).Count)
+}
+
+func TestStructParser_Encode(t *testing.T) {
+	parser, err := StructParser("XYZ", testStructXYZ{})
+	assert.NoError(t, err)
+
+	raw := "$AAXYZ,12.5,K,5130.5000,N,00007.5000,W,A,123519.50,7,*71"
+	m, err := parser(mustParseBaseSentence(t, raw))
+	assert.NoError(t, err)
+
+	encoded, err := Encode(m)
+	assert.NoError(t, err)
+	assert.Equal(t, raw, encoded)
+
+	xyz := m.(testStructXYZ)
+	xyz.Speed = 8
+	xyz.Longitude = 1.25
+	xyz.Level = 3
+	encoded, err = Encode(xyz)
+	assert.NoError(t, err)
+	assert.Equal(t, "$AAXYZ,8,K,5130.5000,N,00115.0000,E,A,123519.50,7,3*77", encoded)
+
+	xyz.Status = "X"
+	_, err = Encode(&xyz)
+	assert.EqualError(t, err, "nmea: AAXYZ invalid status: X")
+}
+
+func TestStructParser_EncodeNewSentence(t *testing.T) {
+	_, err := StructParser("XYZ", testStructXYZ{})
+	assert.NoError(t, err)
+
+	xyz := testStructXYZ{
+		BaseSentence: BaseSentence{Talker: "AA", Type: "XYZ"},
+		Speed:        1.5,
+		Latitude:     -33.5,
+		Longitude:    151.25,
+		Status:       "V",
+		Time:         Time{Valid: true, Hour: 1, Minute: 2, Second: 3},
+		Count:        Int64{Value: 2, Valid: true},
+		Level:        -1,
+	}
+	encoded, err := Encode(xyz)
+	assert.NoError(t, err)
+	assert.Equal(t, "$AAXYZ,1.5,,3330.0000,S,15115.0000,E,V,010203.00,2,-1*03", encoded)
+}
+
+func TestStructParser_EncodeFloatDecimals(t *testing.T) {
+	type FLT struct {
+		BaseSentence
+		Rounded float64 `nmea:"0,float=2"`
+		Default float64 `nmea:"1,float"`
+	}
+	_, err := StructParser("FLT", FLT{})
+	assert.NoError(t, err)
+
+	encoded, err := Encode(FLT{BaseSentence: BaseSentence{Talker: "AA", Type: "FLT"}, Rounded: 1.0 / 3, Default: 0.1 + 0.2})
+	assert.NoError(t, err)
+	assert.Equal(t, "$AAFLT,0.33,0.3*6D", encoded)
+}
+
+func TestStructParser_InvalidStruct(t *testing.T) {
+	type noBase struct {
+		TestZZZ
+	}
+	type badKind struct {
+		BaseSentence
+		Value string `nmea:"0,float"`
+	}
+	type badTag struct {
+		BaseSentence
+		Value string `nmea:"0"`
+	}
+	type unknownKind struct {
+		BaseSentence
+		Value string `nmea:"0,foo"`
+	}
+	type badLatLong struct {
+		BaseSentence
+		Value float64 `nmea:"0,latlong"`
+	}
+	type overlap struct {
+		BaseSentence
+		A float64 `nmea:"0-1,latlong"`
+		B string  `nmea:"1,string"`
+	}
+	type badDecimals struct {
+		BaseSentence
+		Value float64 `nmea:"0,float=x"`
+	}
+	type emptyEnum struct {
+		BaseSentence
+		Value string `nmea:"0,enum"`
+	}
+	var tests = []struct {
+		name string
+		v    Sentence
+		err  string
+	}{
+		{name: "nil", v: nil, err: "nmea: struct parser for sentence type '\"XYZ\"' needs a struct value"},
+		{name: "no base sentence", v: noBase{}, err: "nmea: struct parser type nmea.noBase does not embed BaseSentence"},
+		{name: "kind does not match type", v: badKind{}, err: "nmea: struct field badKind.Value: kind \"float\" can not be used with type string"},
+		{name: "bad tag", v: badTag{}, err: "nmea: struct field badTag.Value: tag \"0\" should be in <index>,<kind> format"},
+		{name: "unknown kind", v: unknownKind{}, err: "nmea: struct field unknownKind.Value: unknown kind \"foo\""},
+		{name: "latlong single field", v: badLatLong{}, err: "nmea: struct field badLatLong.Value: latlong needs two consecutive fields"},
+		{name: "overlapping fields", v: overlap{}, err: "nmea: struct field overlap.B: sentence field 1 is already used by A"},
+		{name: "invalid float decimals", v: badDecimals{}, err: "nmea: struct field badDecimals.Value: invalid number of decimal places \"float=x\""},
+		{name: "enum without options", v: emptyEnum{}, err: "nmea: struct field emptyEnum.Value: enum \"enum\" has no options"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := StructParser("XYZ", tt.v)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestFieldContext(t *testing.T) {
+	assert.Equal(t, "fix quality", fieldContext("FixQuality"))
+	assert.Equal(t, "hdop", fieldContext("HDOP"))
+	assert.Equal(t, "speed", fieldContext("Speed"))
+}
+
+func mustParseBaseSentence(t *testing.T, raw string) BaseSentence {
+	s, err := (&SentenceParser{}).parseBaseSentence(raw)
+	assert.NoError(t, err)
+	return s
+}
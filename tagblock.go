@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // TagBlock struct
@@ -15,6 +16,162 @@ type TagBlock struct {
 	LineCount    int64  // TypeLineCount line count, parameter: -n
 	Source       string // TypeSourceID source identification 15 char max, parameter: -s
 	Text         string // TypeTextString valid character string, parameter -t
+
+	// extra contains unknown and vendor specific parameters in wire format (`x:1,i:vendor`) in the order they were
+	// received. Parameters are kept in a string so that TagBlock stays comparable with ==.
+	extra string
+	// order contains comma separated keys of parameters in the order they were received. It is set only when the
+	// order differs from the order FormatTagBlock uses.
+	order string
+}
+
+// TagBlockParam is a single tag block parameter (key and value)
+type TagBlockParam struct {
+	Key   string
+	Value string
+}
+
+// TagBlockParams is an ordered list of tag block parameters with unique keys
+type TagBlockParams []TagBlockParam
+
+// Get returns the value of the parameter with given key
+func (p TagBlockParams) Get(key string) (string, bool) {
+	for _, param := range p {
+		if param.Key == key {
+			return param.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set sets value of the parameter with given key. New parameters are added to the end of the list.
+func (p *TagBlockParams) Set(key, value string) {
+	for i, param := range *p {
+		if param.Key == key {
+			(*p)[i].Value = value
+			return
+		}
+	}
+	*p = append(*p, TagBlockParam{Key: key, Value: value})
+}
+
+// Delete removes the parameter with given key
+func (p *TagBlockParams) Delete(key string) {
+	for i, param := range *p {
+		if param.Key == key {
+			*p = append((*p)[:i], (*p)[i+1:]...)
+			return
+		}
+	}
+}
+
+// keys returns comma separated keys of the parameters
+func (p TagBlockParams) keys() string {
+	keys := make([]string, len(p))
+	for i, param := range p {
+		keys[i] = param.Key
+	}
+	return strings.Join(keys, FieldSep)
+}
+
+// Extra returns unknown and vendor specific parameters in the order they were received
+func (t TagBlock) Extra() TagBlockParams {
+	if t.extra == "" {
+		return nil
+	}
+	items := strings.Split(t.extra, FieldSep)
+	params := make(TagBlockParams, len(items))
+	for i, item := range items {
+		parts := strings.SplitN(item, ":", 2)
+		params[i] = TagBlockParam{Key: parts[0], Value: parts[1]}
+	}
+	return params
+}
+
+// SetExtra sets value of unknown or vendor specific parameter. New parameters are added after existing ones.
+func (t *TagBlock) SetExtra(key, value string) {
+	params := t.Extra()
+	params.Set(key, value)
+	t.setExtra(params)
+}
+
+// DeleteExtra removes unknown or vendor specific parameter with given key
+func (t *TagBlock) DeleteExtra(key string) {
+	params := t.Extra()
+	params.Delete(key)
+	t.setExtra(params)
+}
+
+func (t *TagBlock) setExtra(params TagBlockParams) {
+	items := make([]string, len(params))
+	for i, param := range params {
+		items[i] = param.Key + ":" + param.Value
+	}
+	t.extra = strings.Join(items, FieldSep)
+}
+
+// params returns parameters of the tag block in the order FormatTagBlock uses when the received order is not known:
+// known parameters first and then extra parameters in their order.
+func (t TagBlock) params() TagBlockParams {
+	params := make(TagBlockParams, 0, 7)
+	if t.Grouping != "" {
+		params = append(params, TagBlockParam{Key: "g", Value: t.Grouping})
+	}
+	if t.LineCount != 0 {
+		params = append(params, TagBlockParam{Key: "n", Value: strconv.FormatInt(t.LineCount, 10)})
+	}
+	if t.Source != "" {
+		params = append(params, TagBlockParam{Key: "s", Value: t.Source})
+	}
+	if t.Destination != "" {
+		params = append(params, TagBlockParam{Key: "d", Value: t.Destination})
+	}
+	if t.Time != 0 {
+		params = append(params, TagBlockParam{Key: "c", Value: strconv.FormatInt(t.Time, 10)})
+	}
+	if t.RelativeTime != 0 {
+		params = append(params, TagBlockParam{Key: "r", Value: strconv.FormatInt(t.RelativeTime, 10)})
+	}
+	if t.Text != "" {
+		params = append(params, TagBlockParam{Key: "t", Value: t.Text})
+	}
+	return append(params, t.Extra()...)
+}
+
+// millisecondsThreshold is the smallest absolute UNIX time value that is considered to be in milliseconds. As
+// seconds, it would be a date in year 5138.
+const millisecondsThreshold = 100_000_000_000
+
+// Timestamp returns UNIX time (parameter c:) as time.Time. Value is treated as milliseconds when it is too large
+// to be seconds. Returns false when tag block does not have time.
+func (t TagBlock) Timestamp() (time.Time, bool) {
+	if t.Time == 0 {
+		return time.Time{}, false
+	}
+	return UnixTimeToTime(t.Time), true
+}
+
+// SetTimestamp sets UNIX time (parameter c:) of the tag block in seconds or milliseconds.
+func (t *TagBlock) SetTimestamp(ts time.Time, milliseconds bool) {
+	if milliseconds {
+		t.Time = ts.UnixNano() / int64(time.Millisecond)
+		return
+	}
+	t.Time = ts.Unix()
+}
+
+// SetGroup sets sentence grouping (parameter g:) of the tag block.
+func (t *TagBlock) SetGroup(g TagBlockGroup) {
+	t.Grouping = g.String()
+}
+
+// UnixTimeToTime converts tag block UNIX time in seconds or milliseconds to time.Time (in UTC). Value is treated
+// as milliseconds when it is too large to be seconds.
+func UnixTimeToTime(v int64) time.Time {
+	if v >= millisecondsThreshold || v <= -millisecondsThreshold {
+		return time.Unix(v/1000, (v%1000)*int64(time.Millisecond)).UTC()
+	}
+	return time.Unix(v, 0).UTC()
 }
 
 // ParseTagBlock parses tag blocks from a sentence string.
@@ -25,18 +182,18 @@ func ParseTagBlock(raw string) (TagBlock, int, error) {
 	if startOfTagBlock == -1 {
 		return TagBlock{}, 0, nil
 	}
-	// tag block is always at the start of line (unless IEC 61162-450). Starts with `\` and ends with `\` and has valid sentence
+	// tag block is always at the start of line (IEC 61162-450 datagram header is removed by DatagramDecoder). Starts with `\` and ends with `\` and has valid sentence
 	// following or <CR><LF>
 	//
 	// Note: tag block group can span multiple lines but we only parse ones that have sentence
 	endOfTagBlock := strings.LastIndexByte(raw, TagBlockSep)
 	if endOfTagBlock <= startOfTagBlock {
-		return TagBlock{}, 0, fmt.Errorf("nmea: sentence tag block is missing '\\' at the end")
+		return TagBlock{}, 0, &TagBlockError{Message: "sentence tag block is missing '\\' at the end"}
 	}
 	tags := raw[startOfTagBlock+1 : endOfTagBlock]
 	sumSepIndex := strings.Index(tags, ChecksumSep)
 	if sumSepIndex == -1 {
-		return TagBlock{}, 0, fmt.Errorf("nmea: tagblock does not contain checksum separator")
+		return TagBlock{}, 0, &TagBlockError{Raw: tags, Message: "tagblock does not contain checksum separator"}
 	}
 
 	var (
@@ -49,16 +206,25 @@ func ParseTagBlock(raw string) (TagBlock, int, error) {
 
 	// Validate the checksum
 	if checksum != checksumRaw {
-		return TagBlock{}, 0, fmt.Errorf("nmea: tagblock checksum mismatch [%s != %s]", checksum, checksumRaw)
+		return TagBlock{}, 0, &TagBlockError{
+			Raw:     tags,
+			Message: fmt.Sprintf("tagblock checksum mismatch [%s != %s]", checksum, checksumRaw),
+			Err:     &ChecksumError{Expected: checksum, Actual: checksumRaw},
+		}
 	}
 
 	items := strings.Split(tags[:sumSepIndex], ",")
+	keys := make([]string, 0, len(items))
 	for _, item := range items {
 		parts := strings.SplitN(item, ":", 2)
 		if len(parts) != 2 {
-			return TagBlock{}, 0, fmt.Errorf("nmea: tagblock field is malformed (should be <key>:<value>) [%s]", item)
+			return TagBlock{}, 0, &TagBlockError{
+				Raw:     tags,
+				Message: fmt.Sprintf("tagblock field is malformed (should be <key>:<value>) [%s]", item),
+			}
 		}
 		key, value := parts[0], parts[1]
+		keys = append(keys, key)
 		switch key {
 		case "c": // UNIX timestamp
 			tagBlock.Time, err = parseInt64(value)
@@ -83,15 +249,102 @@ func ParseTagBlock(raw string) (TagBlock, int, error) {
 			tagBlock.Source = value
 		case "t": // Text string
 			tagBlock.Text = value
+		default: // unknown and vendor specific parameters
+			tagBlock.SetExtra(key, value)
 		}
 	}
+	if order := strings.Join(keys, FieldSep); order != tagBlock.params().keys() {
+		tagBlock.order = order
+	}
 	return tagBlock, endOfTagBlock + 1, nil
 }
 
+// TagBlockGroup is sentence grouping (g: parameter) of tag block. Lines of the group have same group ID and are
+// numbered from 1 to total number of lines in the group.
+//
+// Example: g:1-3-1234 is the first line of a group of 3 lines with group ID 1234
+type TagBlockGroup struct {
+	LineNumber int64 // line number in the group, starting from 1
+	TotalLines int64 // total number of lines in the group
+	GroupID    int64 // group identifier
+}
+
+// ParseTagBlockGroup parses sentence grouping value (without `g:` prefix) in `<line>-<total>-<id>` format.
+func ParseTagBlockGroup(raw string) (TagBlockGroup, error) {
+	parts := strings.Split(raw, "-")
+	if len(parts) != 3 {
+		return TagBlockGroup{}, &TagBlockError{
+			Raw:     raw,
+			Message: fmt.Sprintf("tagblock grouping is malformed (should be <line>-<total>-<id>) [%s]", raw),
+		}
+	}
+	var (
+		g   TagBlockGroup
+		err error
+	)
+	if g.LineNumber, err = parseInt64(parts[0]); err != nil {
+		return TagBlockGroup{}, err
+	}
+	if g.TotalLines, err = parseInt64(parts[1]); err != nil {
+		return TagBlockGroup{}, err
+	}
+	if g.GroupID, err = parseInt64(parts[2]); err != nil {
+		return TagBlockGroup{}, err
+	}
+	if g.TotalLines < 1 || g.LineNumber < 1 || g.LineNumber > g.TotalLines {
+		return TagBlockGroup{}, &TagBlockError{
+			Raw:     raw,
+			Message: fmt.Sprintf("tagblock grouping line number is out of range [%s]", raw),
+		}
+	}
+	return g, nil
+}
+
+// Group returns parsed sentence grouping of the tag block. Returns false when tag block does not have grouping or
+// the grouping is not in `<line>-<total>-<id>` format.
+func (t TagBlock) Group() (TagBlockGroup, bool) {
+	if t.Grouping == "" {
+		return TagBlockGroup{}, false
+	}
+	g, err := ParseTagBlockGroup(t.Grouping)
+	return g, err == nil
+}
+
+// String formats grouping in `<line>-<total>-<id>` format
+func (g TagBlockGroup) String() string {
+	return fmt.Sprintf("%d-%d-%d", g.LineNumber, g.TotalLines, g.GroupID)
+}
+
 func parseInt64(raw string) (int64, error) {
 	i, err := strconv.ParseInt(raw, 10, 64)
 	if err != nil {
-		return 0, fmt.Errorf("nmea: tagblock unable to parse uint64 [%s]", raw)
+		return 0, &TagBlockError{Message: fmt.Sprintf("tagblock unable to parse uint64 [%s]", raw), Err: err}
 	}
 	return i, nil
 }
+
+// FormatTagBlock formats the tag block into wire format (including `\` delimiters and checksum). Empty tag block
+// is formatted as empty string. Parameters of parsed tag block are formatted in the order they were received.
+// Otherwise known parameters are formatted first and extra parameters after them in their order.
+//
+// Example: \g:1-3-1234,s:r3669961,c:1120959341*0C\
+func FormatTagBlock(t TagBlock) string {
+	params := t.params()
+	if len(params) == 0 {
+		return ""
+	}
+	items := make([]string, 0, len(params))
+	if t.order != "" {
+		for _, key := range strings.Split(t.order, FieldSep) {
+			if value, ok := params.Get(key); ok {
+				items = append(items, key+":"+value)
+				params.Delete(key)
+			}
+		}
+	}
+	for _, param := range params {
+		items = append(items, param.Key+":"+param.Value)
+	}
+	fields := strings.Join(items, FieldSep)
+	return string(TagBlockSep) + fields + ChecksumSep + Checksum(fields) + string(TagBlockSep)
+}
@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,6 +41,8 @@ var tagblocktests = []struct {
 		block: TagBlock{
 			Time:   1564827317,
 			Source: "",
+			extra:  "x:NorSat_1",
+			order:  "x,c",
 		},
 		len: 28,
 	},
@@ -49,6 +52,8 @@ var tagblocktests = []struct {
 		block: TagBlock{
 			Time:   1564827317,
 			Source: "",
+			extra:  "x:NorSat_1",
+			order:  "x,c",
 		},
 		len: 28,
 	},
@@ -59,6 +64,8 @@ var tagblocktests = []struct {
 		block: TagBlock{
 			Time:   1564827317000,
 			Source: "",
+			extra:  "x:NorSat_1",
+			order:  "x,c",
 		},
 		len: 31,
 	},
@@ -74,6 +81,7 @@ var tagblocktests = []struct {
 			Source:       "satelite",
 			Text:         "helloworld",
 			LineCount:    13,
+			order:        "s,c,r,d,g,n,t",
 		},
 		len: 72,
 	},
@@ -133,3 +141,144 @@ func TestParseTagBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTagBlockGroup(t *testing.T) {
+	var tests = []struct {
+		name   string
+		raw    string
+		expect TagBlockGroup
+		err    string
+	}{
+		{name: "ok", raw: "1-3-1234", expect: TagBlockGroup{LineNumber: 1, TotalLines: 3, GroupID: 1234}},
+		{name: "last line", raw: "3-3-1", expect: TagBlockGroup{LineNumber: 3, TotalLines: 3, GroupID: 1}},
+		{name: "malformed", raw: "bulk", err: "nmea: tagblock grouping is malformed (should be <line>-<total>-<id>) [bulk]"},
+		{name: "invalid number", raw: "1-x-1234", err: "nmea: tagblock unable to parse uint64 [x]"},
+		{name: "line out of range", raw: "4-3-1234", err: "nmea: tagblock grouping line number is out of range [4-3-1234]"},
+		{name: "zero line", raw: "0-3-1234", err: "nmea: tagblock grouping line number is out of range [0-3-1234]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseTagBlockGroup(tt.raw)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, g)
+			assert.Equal(t, tt.raw, g.String())
+		})
+	}
+}
+
+func TestTagBlock_Group(t *testing.T) {
+	g, ok := TagBlock{Grouping: "2-3-1234"}.Group()
+	assert.True(t, ok)
+	assert.Equal(t, TagBlockGroup{LineNumber: 2, TotalLines: 3, GroupID: 1234}, g)
+
+	_, ok = TagBlock{Grouping: "bulk"}.Group()
+	assert.False(t, ok)
+
+	_, ok = TagBlock{}.Group()
+	assert.False(t, ok)
+}
+
+func TestTagBlockParams(t *testing.T) {
+	var p TagBlockParams
+	p.Set("x", "1")
+	p.Set("y", "2")
+	p.Set("x", "3")
+	assert.Equal(t, TagBlockParams{{Key: "x", Value: "3"}, {Key: "y", Value: "2"}}, p)
+
+	v, ok := p.Get("y")
+	assert.True(t, ok)
+	assert.Equal(t, "2", v)
+	_, ok = p.Get("z")
+	assert.False(t, ok)
+
+	p.Delete("x")
+	assert.Equal(t, TagBlockParams{{Key: "y", Value: "2"}}, p)
+	p.Delete("z")
+	assert.Equal(t, TagBlockParams{{Key: "y", Value: "2"}}, p)
+}
+
+func TestParseTagBlock_ExtraParamsOrder(t *testing.T) {
+	tagBlock, _, err := ParseTagBlock("\\s:r3669961,c:1120959341,x:NorSat_1,i:vendor*15\\")
+	assert.NoError(t, err)
+	assert.Equal(t, TagBlockParams{{Key: "x", Value: "NorSat_1"}, {Key: "i", Value: "vendor"}}, tagBlock.Extra())
+}
+
+func TestFormatTagBlock_ReceivedOrder(t *testing.T) {
+	var tests = []string{
+		"\\c:1553390539,s:Satelite_1*62\\",
+		"\\x:NorSat_1,c:1564827317*42\\",
+		"\\s:GP0001,n:123*17\\",
+		"\\s:satelite,c:1564827317,r:1553390539,d:ara,g:bulk,n:13,t:helloworld*3F\\",
+	}
+	for _, raw := range tests {
+		t.Run(raw, func(t *testing.T) {
+			tagBlock, _, err := ParseTagBlock(raw)
+			assert.NoError(t, err)
+			assert.Equal(t, raw, FormatTagBlock(tagBlock))
+		})
+	}
+}
+
+func TestFormatTagBlock_ChangedParameters(t *testing.T) {
+	tagBlock, _, err := ParseTagBlock("\\c:1553390539,x:1,s:Satelite_1*3D\\")
+	assert.NoError(t, err)
+
+	tagBlock.Source = ""
+	tagBlock.Grouping = "1-2-3"
+	tagBlock.SetExtra("y", "2")
+	assert.Equal(t, "\\c:1553390539,x:1,g:1-2-3,y:2*1D\\", FormatTagBlock(tagBlock))
+
+	tagBlock.DeleteExtra("x")
+	assert.Equal(t, TagBlockParams{{Key: "y", Value: "2"}}, tagBlock.Extra())
+}
+
+func TestTagBlock_Comparable(t *testing.T) {
+	a, _, err := ParseTagBlock("\\s:r3669961,c:1120959341,x:NorSat_1*6E\\")
+	assert.NoError(t, err)
+	b := TagBlock{Source: "r3669961", Time: 1120959341}
+	b.SetExtra("x", "NorSat_1")
+	assert.True(t, a == b)
+
+	b.SetExtra("x", "NorSat_2")
+	assert.False(t, a == b)
+}
+
+func TestUnixTimeToTime(t *testing.T) {
+	var tests = []struct {
+		name   string
+		value  int64
+		expect time.Time
+	}{
+		{name: "seconds", value: 1564827317, expect: time.Date(2019, 8, 3, 10, 15, 17, 0, time.UTC)},
+		{name: "milliseconds", value: 1564827317123, expect: time.Date(2019, 8, 3, 10, 15, 17, 123000000, time.UTC)},
+		{name: "zero", value: 0, expect: time.Unix(0, 0).UTC()},
+		{name: "largest seconds", value: 99999999999, expect: time.Unix(99999999999, 0).UTC()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, UnixTimeToTime(tt.value))
+		})
+	}
+}
+
+func TestTagBlock_Timestamp(t *testing.T) {
+	_, ok := TagBlock{}.Timestamp()
+	assert.False(t, ok)
+
+	ts, ok := TagBlock{Time: 1564827317000}.Timestamp()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, 8, 3, 10, 15, 17, 0, time.UTC), ts)
+
+	var tb TagBlock
+	tb.SetTimestamp(time.Date(2019, 8, 3, 10, 15, 17, 500000000, time.UTC), false)
+	assert.Equal(t, int64(1564827317), tb.Time)
+	tb.SetTimestamp(time.Date(2019, 8, 3, 10, 15, 17, 500000000, time.UTC), true)
+	assert.Equal(t, int64(1564827317500), tb.Time)
+
+	tb.SetGroup(TagBlockGroup{LineNumber: 1, TotalLines: 2, GroupID: 3})
+	assert.Equal(t, "1-2-3", tb.Grouping)
+}
@@ -0,0 +1,228 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TagGroup is a group of lines joined by tag block sentence grouping (g: parameter). Group can contain lines with
+// only a tag block and lines with sentences, for example VDM sentence followed by its VSI sentence.
+type TagGroup struct {
+	// GroupID is group identifier from sentence grouping, 0 for lines without grouping
+	GroupID int64
+	// TagBlock is tag block merged from all lines of the group. For each parameter first non-empty value is used.
+	TagBlock TagBlock
+	// Sentences contains sentences of the group in line order. Lines with only a tag block have no sentence.
+	Sentences []Sentence
+}
+
+// TagGroupAssembler joins lines of tag block groups. Lines without grouping are returned as group of one line.
+// Incomplete groups are discarded after Timeout.
+//
+// Example of group of 3:
+// \g:1-3-1234,s:r3669961,c:1120959341*hh\
+// \g:2-3-1234*hh\!ABVDM,1,1,1,B,.....,0*hh
+// \g:3-3-1234*hh\$ABVSI,r3669961,1,013536.96326433,1386,-98,,*hh
+//
+// TagGroupAssembler is not safe for concurrent use by multiple goroutines.
+type TagGroupAssembler struct {
+	// Parser is used to parse sentences of lines. When nil, parser used by the package level Parse function is used.
+	Parser *SentenceParser
+	// Timeout is the time incomplete group is kept since its first received line. DefaultAssemblerTimeout is used
+	// when zero.
+	Timeout time.Duration
+	// Now returns current time, time.Now is used when nil
+	Now func() time.Time
+
+	groups map[tagGroupKey]*tagGroupLines
+}
+
+// tagGroupKey identifies a pending group. Group IDs are unique only per source, so lines of different sources using
+// the same group ID are kept in separate groups.
+type tagGroupKey struct {
+	source  string
+	groupID int64
+}
+
+type tagGroupLines struct {
+	started   time.Time
+	total     int64
+	received  int64
+	tagBlocks []TagBlock
+	sentences []Sentence
+	seen      []bool
+}
+
+// AddLine parses the line and adds it to the assembler. Line can contain only a tag block. Complete group is
+// returned when the line completes a group.
+func (a *TagGroupAssembler) AddLine(raw string) (*TagGroup, error) {
+	raw = strings.TrimSpace(raw)
+	tagBlock, tagBlockLen, err := ParseTagBlock(raw)
+	if err != nil {
+		return nil, err
+	}
+	if tagBlockLen > 0 && tagBlockLen == len(raw) {
+		return a.Add(tagBlock, nil)
+	}
+	p := a.Parser
+	if p == nil {
+		p = &defaultSentenceParser
+	}
+	s, err := p.Parse(raw)
+	if err != nil {
+		if g, ok := tagBlock.Group(); ok {
+			// group with invalid line can not be completed
+			delete(a.groups, a.groupKey(tagBlock.Source, g.GroupID))
+		}
+		return nil, err
+	}
+	return a.Add(tagBlock, s)
+}
+
+// Add adds tag block and sentence of a line to the assembler. Sentence is nil for lines with only a tag block.
+// Complete group is returned when the line completes a group.
+func (a *TagGroupAssembler) Add(tagBlock TagBlock, s Sentence) (*TagGroup, error) {
+	now := a.now()
+	a.expire(now)
+
+	if tagBlock.Grouping == "" {
+		result := &TagGroup{TagBlock: tagBlock}
+		if s != nil {
+			result.Sentences = []Sentence{s}
+		}
+		return result, nil
+	}
+	g, err := ParseTagBlockGroup(tagBlock.Grouping)
+	if err != nil {
+		return nil, err
+	}
+	if g.TotalLines > maxSequenceLength {
+		return nil, fmt.Errorf("nmea: tagblock group %d has too many lines [%d]", g.GroupID, g.TotalLines)
+	}
+
+	key := a.groupKey(tagBlock.Source, g.GroupID)
+	lines, ok := a.groups[key]
+	if ok && (lines.total != g.TotalLines || lines.seen[g.LineNumber-1]) {
+		// repeated line or different total means that group ID is reused for a new group
+		ok = false
+	}
+	if !ok {
+		lines = &tagGroupLines{
+			started:   now,
+			total:     g.TotalLines,
+			tagBlocks: make([]TagBlock, g.TotalLines),
+			sentences: make([]Sentence, g.TotalLines),
+			seen:      make([]bool, g.TotalLines),
+		}
+		if a.groups == nil {
+			a.groups = map[tagGroupKey]*tagGroupLines{}
+		}
+		a.groups[key] = lines
+	}
+	i := g.LineNumber - 1
+	lines.tagBlocks[i] = tagBlock
+	lines.sentences[i] = s
+	lines.seen[i] = true
+	lines.received++
+	if lines.received < lines.total {
+		return nil, nil
+	}
+	delete(a.groups, key)
+
+	result := &TagGroup{GroupID: g.GroupID}
+	for i, tb := range lines.tagBlocks {
+		result.TagBlock = mergeTagBlocks(result.TagBlock, tb)
+		if lines.sentences[i] != nil {
+			result.Sentences = append(result.Sentences, lines.sentences[i])
+		}
+	}
+	return result, nil
+}
+
+// groupKey returns key of the pending group the line belongs to. Usually only the first line of a group has the
+// source parameter, so line without source joins the only pending group with the same group ID, and line with source
+// adopts the group started by lines without source.
+func (a *TagGroupAssembler) groupKey(source string, groupID int64) tagGroupKey {
+	key := tagGroupKey{source: source, groupID: groupID}
+	if _, ok := a.groups[key]; ok {
+		return key
+	}
+	if source == "" {
+		found, count := key, 0
+		for k := range a.groups {
+			if k.groupID == groupID {
+				found = k
+				count++
+			}
+		}
+		if count == 1 {
+			return found
+		}
+		return key
+	}
+	noSource := tagGroupKey{groupID: groupID}
+	if lines, ok := a.groups[noSource]; ok {
+		delete(a.groups, noSource)
+		a.groups[key] = lines
+	}
+	return key
+}
+
+// Pending returns the number of incomplete groups
+func (a *TagGroupAssembler) Pending() int {
+	a.expire(a.now())
+	return len(a.groups)
+}
+
+func (a *TagGroupAssembler) now() time.Time {
+	if a.Now != nil {
+		return a.Now()
+	}
+	return time.Now()
+}
+
+// expire discards groups that have timed out
+func (a *TagGroupAssembler) expire(now time.Time) {
+	timeout := a.Timeout
+	if timeout == 0 {
+		timeout = DefaultAssemblerTimeout
+	}
+	for key, g := range a.groups {
+		if now.Sub(g.started) > timeout {
+			delete(a.groups, key)
+		}
+	}
+}
+
+// mergeTagBlocks fills empty parameters of dst with parameters of src
+func mergeTagBlocks(dst, src TagBlock) TagBlock {
+	if dst.Time == 0 {
+		dst.Time = src.Time
+	}
+	if dst.RelativeTime == 0 {
+		dst.RelativeTime = src.RelativeTime
+	}
+	if dst.Destination == "" {
+		dst.Destination = src.Destination
+	}
+	if dst.Grouping == "" {
+		dst.Grouping = src.Grouping
+	}
+	if dst.LineCount == 0 {
+		dst.LineCount = src.LineCount
+	}
+	if dst.Source == "" {
+		dst.Source = src.Source
+	}
+	if dst.Text == "" {
+		dst.Text = src.Text
+	}
+	extra := dst.Extra()
+	for _, param := range src.Extra() {
+		if _, ok := extra.Get(param.Key); !ok {
+			dst.SetExtra(param.Key, param.Value)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,157 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagGroupAssembler_AddLine(t *testing.T) {
+	a := TagGroupAssembler{
+		Parser: &SentenceParser{
+			CustomParsers: map[string]ParserFunc{
+				"VSI": func(s BaseSentence) (Sentence, error) {
+					return s, nil
+				},
+			},
+		},
+	}
+	lines := []string{
+		`\g:1-3-1234,s:r3669961,c:1120959341*0c\`,
+		`\g:2-3-1234*58\!AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25`,
+		`\g:3-3-1234*59\$ABVSI,r3669961,1,013536.96326433,1386,-98,,*14`,
+	}
+	for i, line := range lines {
+		g, err := a.AddLine(line)
+		assert.NoError(t, err)
+		if i < len(lines)-1 {
+			assert.Nil(t, g)
+			assert.Equal(t, 1, a.Pending())
+			continue
+		}
+		if assert.NotNil(t, g) {
+			assert.Equal(t, int64(1234), g.GroupID)
+			assert.Equal(t, TagBlock{Time: 1120959341, Grouping: "1-3-1234", Source: "r3669961"}, g.TagBlock)
+			if assert.Len(t, g.Sentences, 2) {
+				assert.Equal(t, TypeVDM, g.Sentences[0].DataType())
+				assert.Equal(t, "VSI", g.Sentences[1].DataType())
+			}
+		}
+	}
+	assert.Equal(t, 0, a.Pending())
+}
+
+func TestTagGroupAssembler_OutOfOrder(t *testing.T) {
+	var a TagGroupAssembler
+	g, err := a.AddLine(`\g:2-2-77*5D\!AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25`)
+	assert.NoError(t, err)
+	assert.Nil(t, g)
+
+	g, err = a.AddLine(`\g:1-2-77,s:src*59\`)
+	assert.NoError(t, err)
+	if assert.NotNil(t, g) {
+		assert.Equal(t, "src", g.TagBlock.Source)
+		assert.Len(t, g.Sentences, 1)
+	}
+}
+
+func TestTagGroupAssembler_Sources(t *testing.T) {
+	var a TagGroupAssembler
+	lines := []string{
+		`\g:1-2-5,s:A*4F\$GPHDT,1.0,T*34`,
+		`\g:1-2-5,s:B*4C\$GPHDT,2.0,T*37`,
+		`\g:2-2-5,s:B*4F\$GPHDT,3.0,T*36`,
+		`\g:2-2-5,s:A*4C\$GPHDT,4.0,T*31`,
+	}
+	var sources []string
+	var headings [][]float64
+	for _, line := range lines {
+		g, err := a.AddLine(line)
+		assert.NoError(t, err)
+		if g == nil {
+			continue
+		}
+		sources = append(sources, g.TagBlock.Source)
+		var h []float64
+		for _, s := range g.Sentences {
+			h = append(h, s.(HDT).Heading)
+		}
+		headings = append(headings, h)
+	}
+	assert.Equal(t, []string{"B", "A"}, sources)
+	assert.Equal(t, [][]float64{{2, 3}, {1, 4}}, headings)
+	assert.Equal(t, 0, a.Pending())
+}
+
+func TestTagGroupAssembler_Ungrouped(t *testing.T) {
+	var a TagGroupAssembler
+	g, err := a.AddLine(`\s:Satelite_1,c:1553390539*62\!AIVDM,1,1,,A,13M@ah0025QdPDTCOl` + "`" + `K6` + "`" + `nV00Sv,0*52`)
+	assert.NoError(t, err)
+	if assert.NotNil(t, g) {
+		assert.Equal(t, int64(0), g.GroupID)
+		assert.Equal(t, "Satelite_1", g.TagBlock.Source)
+		assert.Len(t, g.Sentences, 1)
+	}
+
+	g, err = a.AddLine("$GPHDT,274.07,T*03")
+	assert.NoError(t, err)
+	if assert.NotNil(t, g) {
+		assert.Len(t, g.Sentences, 1)
+	}
+}
+
+func TestTagGroupAssembler_Timeout(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := TagGroupAssembler{
+		Timeout: time.Second,
+		Now:     func() time.Time { return now },
+	}
+	g, err := a.AddLine(`\g:1-2-1234*5a\`)
+	assert.NoError(t, err)
+	assert.Nil(t, g)
+	assert.Equal(t, 1, a.Pending())
+
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, 0, a.Pending())
+}
+
+func TestTagGroupAssembler_Errors(t *testing.T) {
+	var a TagGroupAssembler
+	_, err := a.AddLine(`\g:1-2-1234*5a\`)
+	assert.NoError(t, err)
+
+	_, err = a.AddLine(`\g:2-2-1234*5b\$GPHDT,274.07,T*03`)
+	assert.EqualError(t, err, "nmea: tagblock checksum mismatch [59 != 5B]")
+	assert.Equal(t, 1, a.Pending())
+
+	// group with invalid sentence is discarded
+	_, err = a.AddLine(`\g:2-2-1234*59\$GPHDT,274.07,T*04`)
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [03 != 04]")
+	assert.Equal(t, 0, a.Pending())
+
+	_, err = a.Add(TagBlock{Grouping: "bulk"}, nil)
+	assert.EqualError(t, err, "nmea: tagblock grouping is malformed (should be <line>-<total>-<id>) [bulk]")
+}
+
+func TestMergeTagBlocks(t *testing.T) {
+	result := mergeTagBlocks(
+		TagBlock{Source: "a", extra: "x:1"},
+		TagBlock{Source: "b", Time: 10, extra: "x:2,y:3"},
+	)
+	assert.Equal(t, TagBlock{
+		Source: "a",
+		Time:   10,
+		extra:  "x:1,y:3",
+	}, result)
+}
+
+func TestMergeTagBlocks_DoesNotModifyDestination(t *testing.T) {
+	first := TagBlock{extra: "x:1"}
+
+	merged := mergeTagBlocks(first, TagBlock{extra: "y:2"})
+	mergeTagBlocks(first, TagBlock{extra: "z:3"})
+
+	assert.Equal(t, TagBlockParams{{Key: "x", Value: "1"}}, first.Extra())
+	assert.Equal(t, TagBlockParams{{Key: "x", Value: "1"}, {Key: "y", Value: "2"}}, merged.Extra())
+}
@@ -29,7 +29,7 @@ type THS struct {
 
 // newTHS constructor
 func newTHS(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeTHS)
 	m := THS{
 		BaseSentence: s,
@@ -38,3 +38,11 @@ func newTHS(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the THS sentence fields in wire format
+func (s THS) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.Heading, 3)
+	e.String(s.Status)
+	return e.Fields()
+}
@@ -27,7 +27,7 @@ type TLBTarget struct {
 
 // newTLB constructor
 func newTLB(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeTLB)
 	tlb := TLB{
 		BaseSentence: s,
@@ -50,3 +50,13 @@ func newTLB(s BaseSentence) (Sentence, error) {
 	}
 	return tlb, p.Err()
 }
+
+// EncodeFields returns the TLB sentence fields in wire format
+func (s TLB) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	for _, target := range s.Targets {
+		e.Float64(target.TargetNumber, 0)
+		e.String(target.TargetLabel)
+	}
+	return e.Fields()
+}
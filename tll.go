@@ -31,7 +31,7 @@ type TLL struct {
 
 // newTLL constructor
 func newTLL(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeTLL)
 	return TLL{
 		BaseSentence:    s,
@@ -44,3 +44,21 @@ func newTLL(s BaseSentence) (Sentence, error) {
 		ReferenceTarget: p.EnumString(8, "reference target", "R"),
 	}, p.Err()
 }
+
+// TargetPosition returns position of the target
+func (s TLL) TargetPosition() Position {
+	return Position{Latitude: s.TargetLatitude, Longitude: s.TargetLongitude}
+}
+
+// EncodeFields returns the TLL sentence fields in wire format
+func (s TLL) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.TargetNumber)
+	e.Latitude(s.TargetLatitude, "latitude")
+	e.Longitude(s.TargetLongitude, "longitude")
+	e.String(s.TargetName)
+	e.Time(s.TimeUTC, "UTC time")
+	e.String(s.TargetStatus)
+	e.String(s.ReferenceTarget)
+	return e.Fields()
+}
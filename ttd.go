@@ -25,7 +25,7 @@ type TTD struct {
 
 // newTTD constructor
 func newTTD(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeTTD)
 	m := TTD{
 		BaseSentence:   s,
@@ -36,3 +36,13 @@ func newTTD(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the TTD sentence fields in wire format
+func (s TTD) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.HexInt64(s.NumFragments, 2)
+	e.HexInt64(s.FragmentNumber, 2)
+	e.Int64(s.MessageID)
+	e.SixBitASCIIArmour(s.Payload, "payload")
+	return e.Fields()
+}
@@ -33,7 +33,7 @@ type TTM struct {
 
 // newTTM constructor
 func newTTM(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeTTM)
 	return TTM{
 		BaseSentence:      s,
@@ -54,3 +54,41 @@ func newTTM(s BaseSentence) (Sentence, error) {
 		TypeOfAcquisition: p.EnumString(14, "type of acquisition", "A", "M", "R"),
 	}, p.Err()
 }
+
+// Distance returns target distance. TTM units (K, N and S) are the same as DistanceUnit* units. Returns false when
+// distance or units are empty.
+func (s TTM) Distance() (Distance, bool) {
+	return Distance{Value: s.TargetDistance, Unit: s.SpeedUnits}, valuePresent(s.BaseSentence, 1, s.TargetDistance) && s.SpeedUnits != ""
+}
+
+// CPADistance returns distance of closest point of approach. Returns false when distance or units are empty.
+func (s TTM) CPADistance() (Distance, bool) {
+	return Distance{Value: s.DistanceCPA, Unit: s.SpeedUnits}, valuePresent(s.BaseSentence, 7, s.DistanceCPA) && s.SpeedUnits != ""
+}
+
+// Speed returns target speed. TTM units (K, N and S) are the same as Speed* units. Returns false when speed or units
+// are empty.
+func (s TTM) Speed() (Speed, bool) {
+	return Speed{Value: s.TargetSpeed, Unit: s.SpeedUnits}, valuePresent(s.BaseSentence, 4, s.TargetSpeed) && s.SpeedUnits != ""
+}
+
+// EncodeFields returns the TTM sentence fields in wire format
+func (s TTM) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.TargetNumber)
+	e.Float64(s.TargetDistance, 3)
+	e.Float64(s.Bearing, 3)
+	e.String(s.BearingType)
+	e.Float64(s.TargetSpeed, 3)
+	e.Float64(s.TargetCourse, 3)
+	e.String(s.CourseType)
+	e.Float64(s.DistanceCPA, 3)
+	e.Float64(s.TimeCPA, 3)
+	e.String(s.SpeedUnits)
+	e.String(s.TargetName)
+	e.String(s.TargetStatus)
+	e.String(s.ReferenceTarget)
+	e.Time(s.TimeUTC, "UTC time")
+	e.String(s.TypeOfAcquisition)
+	return e.Fields()
+}
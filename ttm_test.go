@@ -120,3 +120,36 @@ func TestTTM(t *testing.T) {
 		})
 	}
 }
+
+func TestTTM_Accessors(t *testing.T) {
+	s, err := Parse("$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,N,,T,,,M*2A")
+	assert.NoError(t, err)
+	ttm := s.(TTM)
+	distance, ok := ttm.Distance()
+	assert.True(t, ok)
+	assert.Equal(t, Distance{Value: 1.43, Unit: DistanceUnitNauticalMile}, distance)
+	assert.InDelta(t, 2648.36, distance.Meters(), 0.000001)
+	cpa, ok := ttm.CPADistance()
+	assert.True(t, ok)
+	assert.Equal(t, Distance{Value: 1.42, Unit: DistanceUnitNauticalMile}, cpa)
+	speed, ok := ttm.Speed()
+	assert.True(t, ok)
+	assert.Equal(t, Speed{Value: 0.16, Unit: SpeedKnots}, speed)
+
+	s, err = Parse("$RATTM,02,,170.5,T,,264.4,T,,36.9,N,,T,,,M*32")
+	assert.NoError(t, err)
+	_, ok = s.(TTM).Distance()
+	assert.False(t, ok)
+	_, ok = s.(TTM).CPADistance()
+	assert.False(t, ok)
+	_, ok = s.(TTM).Speed()
+	assert.False(t, ok)
+
+	// values without units
+	s, err = Parse("$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,,,T,,,M*64")
+	assert.NoError(t, err)
+	_, ok = s.(TTM).Distance()
+	assert.False(t, ok)
+	_, ok = s.(TTM).Speed()
+	assert.False(t, ok)
+}
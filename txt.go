@@ -26,7 +26,7 @@ type TXT struct {
 
 // newTXT constructor
 func newTXT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeTXT)
 	m := TXT{
 		BaseSentence: s,
@@ -37,3 +37,13 @@ func newTXT(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EncodeFields returns the TXT sentence fields in wire format
+func (s TXT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.ZeroPaddedInt64(s.TotalNumber, 2)
+	e.ZeroPaddedInt64(s.Number, 2)
+	e.ZeroPaddedInt64(s.ID, 2)
+	e.String(s.Message)
+	return e.Fields()
+}
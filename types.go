@@ -33,6 +33,8 @@ const (
 	UnitFahrenheit = TemperatureFahrenheit
 	// UnitDegrees is unit for angular displacement in Degrees
 	UnitDegrees = "D"
+	// UnitInchesOfMercury is unit for pressure in inches of mercury (1 inHg = 3386.389 Pa)
+	UnitInchesOfMercury = "I"
 	// UnitHertz is unit for frequency in Hertz
 	UnitHertz = "H"
 	// UnitLitresPerSecond is unit for volumetric flow in Litres per second
@@ -66,6 +68,8 @@ const (
 	SpeedMeterPerSecond = "M"
 	// SpeedKilometerPerHour is unit of speed of 1 kilometer per hour
 	SpeedKilometerPerHour = "K"
+	// SpeedStatuteMilePerHour is unit of speed of 1 statute mile per hour (used by MWV and TTM)
+	SpeedStatuteMilePerHour = "S"
 )
 
 const (
@@ -230,15 +234,20 @@ func ParseGPS(s string) (float64, error) {
 	}
 }
 
-// FormatGPS formats a GPS/NMEA coordinate
+// FormatGPS formats a GPS/NMEA coordinate. Minutes are rounded to 4 decimals before padding so that rounding up
+// to 60 minutes carries into degrees.
 func FormatGPS(l float64) string {
 	padding := ""
 	degrees := math.Floor(math.Abs(l))
-	fraction := (math.Abs(l) - degrees) * 60
-	if fraction < 10 {
+	minutes := math.Round((math.Abs(l)-degrees)*60*10000) / 10000
+	if minutes >= 60 {
+		degrees++
+		minutes -= 60
+	}
+	if minutes < 10 {
 		padding = "0"
 	}
-	return fmt.Sprintf("%d%s%.4f", int(degrees), padding, fraction)
+	return fmt.Sprintf("%d%s%.4f", int(degrees), padding, minutes)
 }
 
 // ParseDecimal parses a decimal format coordinate.
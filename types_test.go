@@ -132,6 +132,25 @@ func TestLatLongPrint(t *testing.T) {
 	}
 }
 
+func TestFormatGPS_Rounding(t *testing.T) {
+	var tests = []struct {
+		value float64
+		gps   string
+	}{
+		{value: 10.99999999, gps: "1100.0000"},   // minutes round up to 60
+		{value: 9 + 9.99996/60, gps: "910.0000"}, // minutes round up to 10, no padding
+		{value: 9 + 9.99994/60, gps: "909.9999"},
+		{value: -179.999999999, gps: "18000.0000"},
+		{value: 0.0000001, gps: "000.0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%f", tt.value), func(t *testing.T) {
+			assert.Equal(t, tt.gps, FormatGPS(tt.value))
+		})
+	}
+}
+
 func TestTimeParse(t *testing.T) {
 	timetests := []struct {
 		value    string
@@ -33,7 +33,7 @@ type VBW struct {
 
 // newVBW constructor
 func newVBW(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVBW)
 
 	m := VBW{
@@ -60,3 +60,30 @@ func newVBW(s BaseSentence) (Sentence, error) {
 
 	return m, p.Err()
 }
+
+// EncodeFields returns the VBW sentence fields in wire format
+func (s VBW) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.LongitudinalWaterSpeedKnots, 3)
+	e.Float64(s.TransverseWaterSpeedKnots, 3)
+	e.String(vbwStatus(s.WaterSpeedStatus, s.WaterSpeedStatusValid))
+	e.Float64(s.LongitudinalGroundSpeedKnots, 3)
+	e.Float64(s.TransverseGroundSpeedKnots, 3)
+	e.String(vbwStatus(s.GroundSpeedStatus, s.GroundSpeedStatusValid))
+	if len(s.Fields) > 6 || s.SternTraverseWaterSpeedKnots != 0 || s.SternTraverseWaterSpeedStatus != "" ||
+		s.SternTraverseGroundSpeedKnots != 0 || s.SternTraverseGroundSpeedStatus != "" {
+		e.Float64(s.SternTraverseWaterSpeedKnots, 3)
+		e.String(vbwStatus(s.SternTraverseWaterSpeedStatus, s.SternTraverseWaterSpeedStatusValid))
+		e.Float64(s.SternTraverseGroundSpeedKnots, 3)
+		e.String(vbwStatus(s.SternTraverseGroundSpeedStatus, s.SternTraverseGroundSpeedStatusValid))
+	}
+	return e.Fields()
+}
+
+// vbwStatus returns status field value, falling back to valid flag when status is not set
+func vbwStatus(status string, valid bool) string {
+	if status == "" && valid {
+		return StatusValid
+	}
+	return status
+}
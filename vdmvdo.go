@@ -25,14 +25,33 @@ type VDMVDO struct {
 
 // newVDMVDO constructor
 func newVDMVDO(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
-	m := VDMVDO{
+	m := VDMVDO{}
+	err := m.Decode(s)
+	return m, err
+}
+
+// Decode parses base sentence into VDMVDO. Payload memory is reused when it has enough capacity. Implements Decodable.
+func (m *VDMVDO) Decode(s BaseSentence) error {
+	p := newParser(s)
+	payload := m.Payload
+	*m = VDMVDO{
 		BaseSentence:   s,
 		NumFragments:   p.Int64(0, "number of fragments"),
 		FragmentNumber: p.Int64(1, "fragment number"),
 		MessageID:      p.Int64(2, "sequence number"),
 		Channel:        p.String(3, "channel ID"),
-		Payload:        p.SixBitASCIIArmour(4, int(p.Int64(5, "number of padding bits")), "payload"),
+		Payload:        p.sixBitASCIIArmour(payload, 4, int(p.Int64(5, "number of padding bits")), "payload"),
 	}
-	return m, p.Err()
+	return p.Err()
+}
+
+// EncodeFields returns the VDMVDO sentence fields in wire format
+func (s VDMVDO) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Int64(s.NumFragments)
+	e.Int64(s.FragmentNumber)
+	e.OptionalInt64(s.MessageID)
+	e.String(s.Channel)
+	e.SixBitASCIIArmour(s.Payload, "payload")
+	return e.Fields()
 }
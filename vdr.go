@@ -24,7 +24,7 @@ type VDR struct {
 
 // newVDR constructor
 func newVDR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVDR)
 	return VDR{
 		BaseSentence:           s,
@@ -36,3 +36,15 @@ func newVDR(s BaseSentence) (Sentence, error) {
 		DriftUnit:              p.EnumString(5, "drift unit", SpeedKnots),
 	}, p.Err()
 }
+
+// EncodeFields returns the VDR sentence fields in wire format
+func (s VDR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.SetDegreesTrue, 3)
+	e.String(s.SetDegreesTrueUnit)
+	e.Float64(s.SetDegreesMagnetic, 3)
+	e.String(s.SetDegreesMagneticUnit)
+	e.Float64(s.DriftKnots, 3)
+	e.String(s.DriftUnit)
+	return e.Fields()
+}
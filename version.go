@@ -0,0 +1,150 @@
+package nmea
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Version is NMEA 0183 standard version. Version defines layout of sentences that got new fields in later versions
+// of the standard (GSA, GSV, GNS, RMC).
+type Version int
+
+const (
+	// VersionUnknown means that version is not configured. Layout of version dependent sentences is detected from
+	// the number of their fields.
+	VersionUnknown Version = iota
+	// Version2 is NMEA 0183 version 2.x (2.0 - 2.30)
+	Version2
+	// Version30 is NMEA 0183 version 3.0 (3.01)
+	Version30
+	// Version40 is NMEA 0183 version 4.0
+	Version40
+	// Version410 is NMEA 0183 version 4.10, adds system ID to GSA, signal ID to GSV and navigational status to RMC
+	// and GNS
+	Version410
+	// Version411 is NMEA 0183 version 4.11, adds signal ID to GSA
+	Version411
+)
+
+var versionNames = map[Version]string{
+	Version2:   "2.x",
+	Version30:  "3.0",
+	Version40:  "4.0",
+	Version410: "4.10",
+	Version411: "4.11",
+}
+
+// String returns version number (e.g. 4.10), empty string for VersionUnknown
+func (v Version) String() string {
+	return versionNames[v]
+}
+
+// ParseVersion parses NMEA 0183 version number (e.g. "2.3", "3.01", "4.10" or "4.11")
+func ParseVersion(s string) (Version, error) {
+	switch s {
+	case "2", "2.x", "2.0", "2.00", "2.01", "2.1", "2.10", "2.2", "2.20", "2.3", "2.30":
+		return Version2, nil
+	case "3", "3.0", "3.00", "3.01":
+		return Version30, nil
+	case "4", "4.0", "4.00":
+		return Version40, nil
+	case "4.1", "4.10":
+		return Version410, nil
+	case "4.11":
+		return Version411, nil
+	}
+	return VersionUnknown, fmt.Errorf("nmea: unknown NMEA 0183 version %q", s)
+}
+
+// versionOr returns version of the sentence set by SentenceParser or detected version when version is not known.
+// Version detected from earlier sentences of the talker is only the minimum version.
+func (s BaseSentence) versionOr(detected Version) Version {
+	if s.Version == VersionUnknown || (s.versionDetected && detected > s.Version) {
+		return detected
+	}
+	return s.Version
+}
+
+// configuredVersion returns version configured for the talker in SentenceParser. VersionUnknown when version is not
+// configured or it was detected from earlier sentences of the talker.
+func (s BaseSentence) configuredVersion() Version {
+	if s.versionDetected {
+		return VersionUnknown
+	}
+	return s.Version
+}
+
+// versionedField reports if field i introduced in version since is part of the sentence layout. Non-empty field
+// that is not part of the layout is an error.
+func (p *Parser) versionedField(i int, context string, layout, since Version) bool {
+	if i >= len(p.Fields) {
+		return false
+	}
+	if layout >= since {
+		return true
+	}
+	if p.Fields[i] != "" {
+		p.setFieldErr(&FieldError{
+			Index:   i,
+			Context: context,
+			Value:   p.Fields[i],
+			Reason:  fmt.Sprintf("field is not in NMEA 0183 %s layout", layout),
+		})
+	}
+	return false
+}
+
+// TalkerVersion returns NMEA 0183 version of the talker: version set in TalkerVersions or Version, or version
+// detected by VersionDetector. VersionUnknown when version is not known.
+func (p *SentenceParser) TalkerVersion(talker string) Version {
+	v, _ := p.talkerVersion(talker)
+	return v
+}
+
+// talkerVersion returns NMEA 0183 version of the talker and reports if the version was detected
+func (p *SentenceParser) talkerVersion(talker string) (Version, bool) {
+	if v, ok := p.TalkerVersions[talker]; ok {
+		return v, false
+	}
+	if p.Version != VersionUnknown || p.VersionDetector == nil {
+		return p.Version, false
+	}
+	return p.VersionDetector.Version(talker)
+}
+
+// VersionDetector remembers NMEA 0183 versions of talkers revealed by layouts of version dependent sentences (see
+// SentenceParser.VersionDetector). Zero value is ready to use. VersionDetector is safe for concurrent use by multiple
+// goroutines.
+type VersionDetector struct {
+	mu       sync.RWMutex
+	versions map[string]Version
+}
+
+// Version returns version detected for the talker. Returns false when version of the talker is not known.
+func (d *VersionDetector) Version(talker string) (Version, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	v, ok := d.versions[talker]
+	return v, ok
+}
+
+// detect remembers version revealed by the layout of version dependent sentence for its talker. The highest revealed
+// version is kept. Layouts of NMEA 0183 2.x do not reveal the version, as later versions can send the same layout.
+func (d *VersionDetector) detect(s Sentence) {
+	l, ok := s.(interface{ Layout() Version })
+	if !ok {
+		return
+	}
+	v := l.Layout()
+	if v <= Version2 {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.versions == nil {
+		d.versions = map[string]Version{}
+	}
+	if v > d.versions[s.TalkerID()] {
+		d.versions[s.TalkerID()] = v
+	}
+}
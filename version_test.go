@@ -0,0 +1,248 @@
+package nmea
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	var testCases = []struct {
+		when      string
+		expect    Version
+		expectErr string
+	}{
+		{when: "2.3", expect: Version2},
+		{when: "2.x", expect: Version2},
+		{when: "3.01", expect: Version30},
+		{when: "4.0", expect: Version40},
+		{when: "4.1", expect: Version410},
+		{when: "4.10", expect: Version410},
+		{when: "4.11", expect: Version411},
+		{when: "", expectErr: `nmea: unknown NMEA 0183 version ""`},
+		{when: "5.0", expectErr: `nmea: unknown NMEA 0183 version "5.0"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.when, func(t *testing.T) {
+			v, err := ParseVersion(tc.when)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, v)
+
+			again, err := ParseVersion(v.String())
+			assert.NoError(t, err)
+			assert.Equal(t, v, again)
+		})
+	}
+	assert.Equal(t, "", VersionUnknown.String())
+}
+
+func TestSentenceParser_LayoutDetected(t *testing.T) {
+	var testCases = []struct {
+		when   string
+		expect Version
+	}{
+		{when: "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E", expect: Version2},
+		{when: "$GNRMC,102014.00,A,5550.6082,N,03732.2488,E,000.00000,092.9,300518,,,A*41", expect: Version2},
+		{when: "$GNRMC,102014.00,A,5550.6082,N,03732.2488,E,000.00000,092.9,300518,,,A,V*3B", expect: Version410},
+		{when: "$GPGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138*15", expect: Version30},
+		{when: "$GPGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138,S*6A", expect: Version410},
+		{when: "$GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83*13", expect: Version2},
+		{when: "$GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83,4*0B", expect: Version410},
+		{when: "$GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83,4,1*16", expect: Version411},
+		{when: "$GLGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*6B", expect: Version2},
+		{when: "$GAGSV,3,1,09,02,00,179,,04,09,321,,07,11,134,11,11,10,227,,7*7F", expect: Version410},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.when, func(t *testing.T) {
+			s, err := Parse(tc.when)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, s.(layouter).Layout())
+			// version is set only when configured
+			assert.Equal(t, VersionUnknown, s.(baseSentencer).baseSentence().Version)
+		})
+	}
+}
+
+type layouter interface {
+	Layout() Version
+}
+
+func TestSentenceParser_TalkerVersions(t *testing.T) {
+	p := SentenceParser{
+		Version:        Version2,
+		TalkerVersions: map[string]Version{"GN": Version410},
+	}
+
+	// fields introduced in later version than the talker version are not parsed and reported as errors
+	s, err := p.Parse("$GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83,4,1*16")
+	assert.EqualError(t, err, "nmea: GNGSA invalid signal ID: field is not in NMEA 0183 4.10 layout")
+	gsa := s.(GSA)
+	assert.Equal(t, Version410, gsa.Version)
+	assert.Equal(t, Version410, gsa.Layout())
+	assert.Equal(t, int64(4), gsa.SystemID)
+	assert.Equal(t, int64(0), gsa.SignalID)
+
+	s, err = p.Parse("$GPGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138,S*6A")
+	assert.EqualError(t, err, "nmea: GPGNS invalid navigation status: field is not in NMEA 0183 2.x layout")
+	gns := s.(GNS)
+	assert.Equal(t, Version2, gns.Version)
+	assert.Equal(t, "", gns.NavStatus)
+
+	s, err = p.Parse("$GPRMC,102014.00,A,5550.6082,N,03732.2488,E,000.00000,092.9,300518,,,A,V*25")
+	var fieldErrs FieldErrors
+	assert.EqualError(t, err, "nmea: GPRMC invalid navigation status: field is not in NMEA 0183 2.x layout")
+	assert.Equal(t, "", s.(RMC).NavStatus)
+
+	// empty field is not an error
+	_, err = p.Parse("$GPRMC,102014.00,A,5550.6082,N,03732.2488,E,000.00000,092.9,300518,,,A,*73")
+	assert.NoError(t, err)
+
+	// lenient mode reports the field with its index
+	lenient := p
+	lenient.Lenient = true
+	_, err = lenient.Parse("$GPRMC,102014.00,A,5550.6082,N,03732.2488,E,000.00000,092.9,300518,,,A,V*25")
+	assert.True(t, errors.As(err, &fieldErrs))
+	assert.Equal(t, []int{12}, fieldErrs.Indices())
+
+	// missing fields of the version are not an error
+	s, err = p.Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	assert.Equal(t, Version410, s.(RMC).Version)
+	assert.Equal(t, Version410, s.(RMC).Layout())
+
+	// version is set for sentences without version dependent layout
+	s, err = p.Parse("$GPHDT,123.456,T*32")
+	assert.NoError(t, err)
+	assert.Equal(t, Version2, s.(HDT).Version)
+}
+
+func TestSentenceParser_VersionDetector(t *testing.T) {
+	p := SentenceParser{VersionDetector: &VersionDetector{}}
+
+	// version is not known until a sentence of the talker reveals it
+	s, err := p.Parse("$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C")
+	assert.NoError(t, err)
+	assert.Equal(t, VersionUnknown, s.(GGA).Version)
+
+	// 2.x layout does not reveal the version
+	_, err = p.Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	assert.Equal(t, VersionUnknown, p.TalkerVersion("GN"))
+
+	// GSV with signal ID reveals 4.10
+	_, err = p.Parse("$GNGSV,1,1,01,02,00,179,,1*46")
+	assert.NoError(t, err)
+	assert.Equal(t, Version410, p.TalkerVersion("GN"))
+	v, ok := p.VersionDetector.Version("GN")
+	assert.True(t, ok)
+	assert.Equal(t, Version410, v)
+	assert.Equal(t, VersionUnknown, p.TalkerVersion("GP"))
+
+	s, err = p.Parse("$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C")
+	assert.NoError(t, err)
+	assert.Equal(t, Version410, s.(GGA).Version)
+
+	// detected version is the minimum layout, later version is detected from the fields
+	s, err = p.Parse("$GNGSA,A,3,13,12,22,19,08,21,,,,,,,1.05,0.64,0.83,4,1*16")
+	assert.NoError(t, err)
+	assert.Equal(t, Version411, s.(GSA).Layout())
+	assert.Equal(t, int64(1), s.(GSA).SignalID)
+	assert.Equal(t, Version411, p.TalkerVersion("GN"))
+
+	// sentence without fields of the detected version is encoded as it was received
+	s, err = p.Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	assert.Equal(t, Version411, s.(RMC).Version)
+	raw, err := Encode(s)
+	assert.NoError(t, err)
+	assert.Equal(t, "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E", raw)
+}
+
+func TestSentenceParser_VersionDetectorConfigured(t *testing.T) {
+	p := SentenceParser{
+		VersionDetector: &VersionDetector{},
+		TalkerVersions:  map[string]Version{"GA": Version410},
+	}
+	_, err := p.Parse("$GAGSV,1,1,01,02,00,179,,B*3A")
+	assert.NoError(t, err)
+	_, ok := p.VersionDetector.Version("GA")
+	assert.False(t, ok)
+
+	// version set for all talkers disables detection
+	p = SentenceParser{VersionDetector: &VersionDetector{}, Version: Version411}
+	s, err := p.Parse("$GNGSV,1,1,01,02,00,179,,1*46")
+	assert.NoError(t, err)
+	assert.Equal(t, Version411, s.(GSV).Version)
+	_, ok = p.VersionDetector.Version("GN")
+	assert.False(t, ok)
+}
+
+func TestEncode_Version(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		when   Sentence
+		expect string
+	}{
+		{
+			name: "RMC 4.10 has navigation status",
+			when: RMC{
+				BaseSentence: BaseSentence{Talker: "GN", Type: TypeRMC, Version: Version410},
+				Validity:     ValidRMC,
+				FFAMode:      FAAModeAutonomous,
+			},
+			expect: "$GNRMC,,A,0000.0000,N,00000.0000,E,0,0,,0,E,A,*37",
+		},
+		{
+			name: "RMC 2.x has no navigation status",
+			when: RMC{
+				BaseSentence: BaseSentence{Talker: "GN", Type: TypeRMC, Version: Version2},
+				Validity:     ValidRMC,
+				FFAMode:      FAAModeAutonomous,
+				NavStatus:    NavStatusSafe,
+			},
+			expect: "$GNRMC,,A,0000.0000,N,00000.0000,E,0,0,,0,E,A*1B",
+		},
+		{
+			name: "GSA 4.11 has signal ID",
+			when: GSA{
+				BaseSentence: BaseSentence{Talker: "GN", Type: TypeGSA, Version: Version411},
+				Mode:         Auto,
+				FixType:      Fix3D,
+				SystemID:     1,
+				SignalID:     6,
+			},
+			expect: "$GNGSA,A,3,,,,,,,,,,,,,0,0,0,1,6*35",
+		},
+		{
+			name: "GSA 4.0 has no system ID",
+			when: GSA{
+				BaseSentence: BaseSentence{Talker: "GN", Type: TypeGSA, Version: Version40},
+				Mode:         Auto,
+				FixType:      Fix3D,
+				SystemID:     1,
+			},
+			expect: "$GNGSA,A,3,,,,,,,,,,,,,0,0,0*32",
+		},
+		{
+			name: "GSV 4.10 has signal ID",
+			when: GSV{
+				BaseSentence:  BaseSentence{Talker: "GA", Type: TypeGSV, Version: Version410},
+				TotalMessages: 1,
+				MessageNumber: 1,
+			},
+			expect: "$GAGSV,1,1,0,0*44",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := Encode(tc.when)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, raw)
+		})
+	}
+}
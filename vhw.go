@@ -20,7 +20,7 @@ type VHW struct {
 
 // newVHW constructor
 func newVHW(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVHW)
 	return VHW{
 		BaseSentence:           s,
@@ -30,3 +30,29 @@ func newVHW(s BaseSentence) (Sentence, error) {
 		SpeedThroughWaterKPH:   p.Float64(6, "speed through water in kilometers per hour"),
 	}, p.Err()
 }
+
+// SpeedThroughWater returns speed through water. Speed in knots is preferred, km/h is used when knots are not
+// available. Returns false when speed is missing.
+func (s VHW) SpeedThroughWater() (Speed, bool) {
+	switch {
+	case valuePresent(s.BaseSentence, 4, s.SpeedThroughWaterKnots):
+		return Speed{Value: s.SpeedThroughWaterKnots, Unit: SpeedKnots}, true
+	case valuePresent(s.BaseSentence, 6, s.SpeedThroughWaterKPH):
+		return Speed{Value: s.SpeedThroughWaterKPH, Unit: SpeedKilometerPerHour}, true
+	}
+	return Speed{}, false
+}
+
+// EncodeFields returns the VHW sentence fields in wire format
+func (s VHW) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.TrueHeading, 3)
+	e.Fixed(HeadingTrue)
+	e.Float64(s.MagneticHeading, 3)
+	e.Fixed(HeadingMagnetic)
+	e.Float64(s.SpeedThroughWaterKnots, 3)
+	e.Fixed(SpeedKnots)
+	e.Float64(s.SpeedThroughWaterKPH, 3)
+	e.Fixed(SpeedKilometerPerHour)
+	return e.Fields()
+}
@@ -45,3 +45,26 @@ func TestVHW(t *testing.T) {
 		})
 	}
 }
+
+func TestVHW_SpeedThroughWater(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		raw      string
+		expect   Speed
+		expectOK bool
+	}{
+		{name: "knots", raw: "$VWVHW,45.0,T,43.0,M,3.5,N,6.4,K*56", expect: Speed{Value: 3.5, Unit: SpeedKnots}, expectOK: true},
+		{name: "kph only", raw: "$VWVHW,45.0,T,43.0,M,,N,6.4,K*7E", expect: Speed{Value: 6.4, Unit: SpeedKilometerPerHour}, expectOK: true},
+		{name: "zero speed", raw: "$VWVHW,45.0,T,43.0,M,0.0,N,0.0,K*52", expect: Speed{Value: 0, Unit: SpeedKnots}, expectOK: true},
+		{name: "missing", raw: "$VWVHW,45.0,T,43.0,M,,N,,K*52", expect: Speed{}, expectOK: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.raw)
+			assert.NoError(t, err)
+			speed, ok := s.(VHW).SpeedThroughWater()
+			assert.Equal(t, tc.expectOK, ok)
+			assert.Equal(t, tc.expect, speed)
+		})
+	}
+}
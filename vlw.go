@@ -26,7 +26,7 @@ type VLW struct {
 
 // newVLW constructor
 func newVLW(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVLW)
 
 	vlw := VLW{
@@ -44,3 +44,19 @@ func newVLW(s BaseSentence) (Sentence, error) {
 	}
 	return vlw, p.Err()
 }
+
+// EncodeFields returns the VLW sentence fields in wire format
+func (s VLW) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.TotalInWater, 3)
+	e.String(s.TotalInWaterUnit)
+	e.Float64(s.SinceResetInWater, 3)
+	e.String(s.SinceResetInWaterUnit)
+	if len(s.Fields) > 4 || s.TotalOnGroundUnit != "" || s.SinceResetOnGroundUnit != "" {
+		e.Float64(s.TotalOnGround, 3)
+		e.String(s.TotalOnGroundUnit)
+		e.Float64(s.SinceResetOnGround, 3)
+		e.String(s.SinceResetOnGroundUnit)
+	}
+	return e.Fields()
+}
@@ -20,7 +20,7 @@ type VPW struct {
 
 // newVPW constructor
 func newVPW(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVPW)
 	return VPW{
 		BaseSentence:   s,
@@ -30,3 +30,13 @@ func newVPW(s BaseSentence) (Sentence, error) {
 		SpeedMPSUnit:   p.EnumString(3, "wind speed in meters per second unit", SpeedMeterPerSecond),
 	}, p.Err()
 }
+
+// EncodeFields returns the VPW sentence fields in wire format
+func (s VPW) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.SpeedKnots, 3)
+	e.String(s.SpeedKnotsUnit)
+	e.Float64(s.SpeedMPS, 3)
+	e.String(s.SpeedMPSUnit)
+	return e.Fields()
+}
@@ -1,5 +1,7 @@
 package nmea
 
+import "time"
+
 const (
 	// TypeVSD type of VSD sentence for AIS voyage static data.
 	TypeVSD = "VSD"
@@ -69,7 +71,7 @@ type VSD struct {
 
 // newVSD constructor
 func newVSD(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVSD)
 	m := VSD{
 		BaseSentence:          s,
@@ -85,3 +87,50 @@ func newVSD(s BaseSentence) (Sentence, error) {
 	}
 	return m, p.Err()
 }
+
+// EstimatedArrival returns estimated UTC time of arrival. Sentence does not contain year, year is chosen so that
+// the result is closest to the reference time (time.Now() when reference time is zero). Returns false when any of the
+// fields is empty or not available (day 00, month 00 or time 24:60).
+func (s VSD) EstimatedArrival(ref time.Time) (time.Time, bool) {
+	if !s.EstimatedArrivalTime.Valid || !s.EstimatedArrivalDay.Valid || !s.EstimatedArrivalMonth.Valid {
+		return time.Time{}, false
+	}
+	hhmmss := s.EstimatedArrivalTime.Value
+	t := Time{Valid: true, Hour: int(hhmmss / 10000), Minute: int(hhmmss / 100 % 100), Second: int(hhmmss % 100)}
+	day, month := int(s.EstimatedArrivalDay.Value), int(s.EstimatedArrivalMonth.Value)
+	// leap year is checked with candidate years
+	if validateDate(2000, month, day) != nil || validateTime(t) != nil {
+		return time.Time{}, false
+	}
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+	ref = ref.UTC()
+
+	var result time.Time
+	for _, year := range []int{ref.Year() - 1, ref.Year(), ref.Year() + 1} {
+		if validateDate(year, month, day) != nil {
+			continue // 29 February of non-leap year
+		}
+		candidate := dateTimeUTC(year, month, day, t)
+		if result.IsZero() || absDuration(candidate.Sub(ref)) < absDuration(result.Sub(ref)) {
+			result = candidate
+		}
+	}
+	return result, !result.IsZero()
+}
+
+// EncodeFields returns the VSD sentence fields in wire format
+func (s VSD) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.NullInt64(s.TypeOfShipAndCargo)
+	e.NullFloat64(s.StaticDraughtMeters, 3)
+	e.NullInt64(s.PersonsOnBoard)
+	e.String(s.Destination)
+	e.NullInt64(s.EstimatedArrivalTime)
+	e.NullInt64(s.EstimatedArrivalDay)
+	e.NullInt64(s.EstimatedArrivalMonth)
+	e.NullInt64(s.NavigationalStatus)
+	e.NullInt64(s.RegionalApplication)
+	return e.Fields()
+}
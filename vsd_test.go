@@ -3,6 +3,7 @@ package nmea
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestVSD(t *testing.T) {
@@ -83,3 +84,82 @@ func TestVSD(t *testing.T) {
 		})
 	}
 }
+
+func TestVSD_EstimatedArrival(t *testing.T) {
+	ref := time.Date(2020, 12, 20, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name     string
+		when     VSD
+		expect   time.Time
+		expectOk bool
+	}{
+		{
+			name: "this year",
+			when: VSD{
+				EstimatedArrivalTime:  Int64{Value: 220516, Valid: true},
+				EstimatedArrivalDay:   Int64{Value: 24, Valid: true},
+				EstimatedArrivalMonth: Int64{Value: 12, Valid: true},
+			},
+			expect:   time.Date(2020, 12, 24, 22, 5, 16, 0, time.UTC),
+			expectOk: true,
+		},
+		{
+			name: "next year",
+			when: VSD{
+				EstimatedArrivalTime:  Int64{Value: 120000, Valid: true},
+				EstimatedArrivalDay:   Int64{Value: 2, Valid: true},
+				EstimatedArrivalMonth: Int64{Value: 1, Valid: true},
+			},
+			expect:   time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+			expectOk: true,
+		},
+		{
+			name: "29 February of leap year",
+			when: VSD{
+				EstimatedArrivalTime:  Int64{Value: 120000, Valid: true},
+				EstimatedArrivalDay:   Int64{Value: 29, Valid: true},
+				EstimatedArrivalMonth: Int64{Value: 2, Valid: true},
+			},
+			expect:   time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC),
+			expectOk: true,
+		},
+		{
+			name: "31 April",
+			when: VSD{
+				EstimatedArrivalTime:  Int64{Value: 120000, Valid: true},
+				EstimatedArrivalDay:   Int64{Value: 31, Valid: true},
+				EstimatedArrivalMonth: Int64{Value: 4, Valid: true},
+			},
+		},
+		{
+			name: "time not available",
+			when: VSD{
+				EstimatedArrivalTime:  Int64{Value: 246000, Valid: true},
+				EstimatedArrivalDay:   Int64{Value: 2, Valid: true},
+				EstimatedArrivalMonth: Int64{Value: 1, Valid: true},
+			},
+		},
+		{
+			name: "day not available",
+			when: VSD{
+				EstimatedArrivalTime:  Int64{Value: 120000, Valid: true},
+				EstimatedArrivalDay:   Int64{Value: 0, Valid: true},
+				EstimatedArrivalMonth: Int64{Value: 1, Valid: true},
+			},
+		},
+		{
+			name: "month unchanged",
+			when: VSD{
+				EstimatedArrivalTime: Int64{Value: 120000, Valid: true},
+				EstimatedArrivalDay:  Int64{Value: 2, Valid: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := tt.when.EstimatedArrival(ref)
+			assert.Equal(t, tt.expectOk, ok)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
@@ -25,7 +25,7 @@ type VTG struct {
 // newVTG parses the VTG sentence into this struct.
 // e.g: $GPVTG,360.0,T,348.7,M,000.0,N,000.0,K*43
 func newVTG(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVTG)
 	vtg := VTG{
 		BaseSentence:     s,
@@ -39,3 +39,37 @@ func newVTG(s BaseSentence) (Sentence, error) {
 	}
 	return vtg, p.Err()
 }
+
+// FillTracks returns copy of the sentence with missing true or magnetic track calculated from the other track and
+// magnetic declination (degrees, positive east, see MagneticDeclination). Track is missing when its field is empty in
+// parsed sentence or when it is zero in sentence without fields.
+func (s VTG) FillTracks(declination float64) VTG {
+	trueMissing, magneticMissing := s.TrueTrack == 0, s.MagneticTrack == 0
+	if len(s.Fields) > 2 {
+		trueMissing, magneticMissing = s.Fields[0] == "", s.Fields[2] == ""
+	}
+	switch {
+	case trueMissing && !magneticMissing:
+		s.TrueTrack = trueFromMagnetic(s.MagneticTrack, declination)
+	case magneticMissing && !trueMissing:
+		s.MagneticTrack = magneticFromTrue(s.TrueTrack, declination)
+	}
+	return s
+}
+
+// EncodeFields returns the VTG sentence fields in wire format
+func (s VTG) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.TrueTrack, 3)
+	e.Fixed(HeadingTrue)
+	e.Float64(s.MagneticTrack, 3)
+	e.Fixed(HeadingMagnetic)
+	e.Float64(s.GroundSpeedKnots, 3)
+	e.Fixed(SpeedKnots)
+	e.Float64(s.GroundSpeedKPH, 3)
+	e.Fixed(SpeedKilometerPerHour)
+	if len(s.Fields) > 8 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
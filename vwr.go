@@ -28,7 +28,7 @@ type VWR struct {
 
 // newVWR constructor
 func newVWR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVWR)
 	return VWR{
 		BaseSentence:         s,
@@ -42,3 +42,17 @@ func newVWR(s BaseSentence) (Sentence, error) {
 		SpeedKPHUnit:         p.EnumString(7, "wind speed in kilometers per hour unit", SpeedKilometerPerHour),
 	}, p.Err()
 }
+
+// EncodeFields returns the VWR sentence fields in wire format
+func (s VWR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.MeasuredAngle, 3)
+	e.String(s.MeasuredDirectionBow)
+	e.Float64(s.SpeedKnots, 3)
+	e.String(s.SpeedKnotsUnit)
+	e.Float64(s.SpeedMPS, 3)
+	e.String(s.SpeedMPSUnit)
+	e.Float64(s.SpeedKPH, 3)
+	e.String(s.SpeedKPHUnit)
+	return e.Fields()
+}
@@ -26,7 +26,7 @@ type VWT struct {
 
 // newVWT constructor
 func newVWT(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeVWT)
 	return VWT{
 		BaseSentence:     s,
@@ -40,3 +40,17 @@ func newVWT(s BaseSentence) (Sentence, error) {
 		SpeedKPHUnit:     p.EnumString(7, "wind speed in kilometers per hour unit", SpeedKilometerPerHour),
 	}, p.Err()
 }
+
+// EncodeFields returns the VWT sentence fields in wire format
+func (s VWT) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Float64(s.TrueAngle, 3)
+	e.String(s.TrueDirectionBow)
+	e.Float64(s.SpeedKnots, 3)
+	e.String(s.SpeedKnotsUnit)
+	e.Float64(s.SpeedMPS, 3)
+	e.String(s.SpeedMPSUnit)
+	e.Float64(s.SpeedKPH, 3)
+	e.String(s.SpeedKPHUnit)
+	return e.Fields()
+}
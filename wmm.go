@@ -0,0 +1,365 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// wmmMaxDegree is the degree of World Magnetic Model spherical harmonic expansion
+	wmmMaxDegree = 12
+	// wmmReferenceRadius is geomagnetic reference radius in metres
+	wmmReferenceRadius = 6371200.0
+	// wmmValidityYears is the validity period of each World Magnetic Model
+	wmmValidityYears = 5
+)
+
+// MagneticField is geomagnetic field calculated with World Magnetic Model
+type MagneticField struct {
+	North       float64 // X, northward component in nT
+	East        float64 // Y, eastward component in nT
+	Down        float64 // Z, downward component in nT
+	Horizontal  float64 // H, horizontal intensity in nT
+	Total       float64 // F, total intensity in nT
+	Declination float64 // D, angle between true north and magnetic north in degrees, positive east
+	Inclination float64 // I, angle between horizontal plane and the field in degrees, positive down
+}
+
+// wmmModel is World Magnetic Model with Gauss coefficients at the epoch
+type wmmModel struct {
+	name         string
+	epoch        float64 // decimal year
+	coefficients []wmmCoefficient
+}
+
+// wmmCoefficient is Gauss coefficient of degree n and order m (nT) and its secular variation (nT/year)
+type wmmCoefficient struct {
+	n, m       int
+	g, h       float64
+	gDot, hDot float64
+}
+
+// wmmModels contains World Magnetic Models in epoch order. Coefficients are from WMM.COF files published by NOAA
+// NCEI and British Geological Survey.
+var wmmModels = []wmmModel{
+	{
+		name:  "WMM-2020",
+		epoch: 2020.0,
+		coefficients: []wmmCoefficient{
+			{1, 0, -29404.5, 0, 6.7, 0},
+			{1, 1, -1450.7, 4652.9, 7.7, -25.1},
+			{2, 0, -2500.0, 0, -11.5, 0},
+			{2, 1, 2982.0, -2991.6, -7.1, -30.2},
+			{2, 2, 1676.8, -734.8, -2.2, -23.9},
+			{3, 0, 1363.9, 0, 2.8, 0},
+			{3, 1, -2381.0, -82.2, -6.2, 5.7},
+			{3, 2, 1236.2, 241.8, 3.4, -1.0},
+			{3, 3, 525.7, -542.9, -12.2, 1.1},
+			{4, 0, 903.1, 0, -1.1, 0},
+			{4, 1, 809.4, 282.0, -1.6, 0.2},
+			{4, 2, 86.2, -158.4, -6.0, 6.9},
+			{4, 3, -309.4, 199.8, 5.4, 3.7},
+			{4, 4, 47.9, -350.1, -5.5, -5.6},
+			{5, 0, -234.4, 0, -0.3, 0},
+			{5, 1, 363.1, 47.7, 0.6, 0.1},
+			{5, 2, 187.8, 208.4, -0.7, 2.5},
+			{5, 3, -140.7, -121.3, 0.1, -0.9},
+			{5, 4, -151.2, 32.2, 1.2, 3.0},
+			{5, 5, 13.7, 99.1, 1.0, 0.5},
+			{6, 0, 65.9, 0, -0.6, 0},
+			{6, 1, 65.6, -19.1, -0.4, 0.1},
+			{6, 2, 73.0, 25.0, 0.5, -1.8},
+			{6, 3, -121.5, 52.7, 1.4, -1.4},
+			{6, 4, -36.2, -64.4, -1.4, 0.9},
+			{6, 5, 13.5, 9.0, 0, 0.1},
+			{6, 6, -64.7, 68.1, 0.8, 1.0},
+			{7, 0, 80.6, 0, -0.1, 0},
+			{7, 1, -76.8, -51.4, -0.3, 0.5},
+			{7, 2, -8.3, -16.8, -0.1, 0.6},
+			{7, 3, 56.5, 2.3, 0.7, -0.7},
+			{7, 4, 15.8, 23.5, 0.2, -0.2},
+			{7, 5, 6.4, -2.2, -0.5, -1.2},
+			{7, 6, -7.2, -27.2, -0.8, 0.2},
+			{7, 7, 9.8, -1.9, 1.0, 0.3},
+			{8, 0, 23.6, 0, -0.1, 0},
+			{8, 1, 9.8, 8.4, 0.1, -0.3},
+			{8, 2, -17.5, -15.3, -0.1, 0.7},
+			{8, 3, -0.4, 12.8, 0.5, -0.2},
+			{8, 4, -21.1, -11.8, -0.1, 0.5},
+			{8, 5, 15.3, 14.9, 0.4, -0.3},
+			{8, 6, 13.7, 3.6, 0.5, -0.5},
+			{8, 7, -16.5, -6.9, 0, 0.4},
+			{8, 8, -0.3, 2.8, 0.4, 0.1},
+			{9, 0, 5.0, 0, -0.1, 0},
+			{9, 1, 8.2, -23.3, -0.2, -0.3},
+			{9, 2, 2.9, 11.1, 0, 0.2},
+			{9, 3, -1.4, 9.8, 0.4, -0.4},
+			{9, 4, -1.1, -5.1, -0.3, 0.4},
+			{9, 5, -13.3, -6.2, 0, 0.1},
+			{9, 6, 1.1, 7.8, 0.3, 0},
+			{9, 7, 8.9, 0.4, 0, -0.2},
+			{9, 8, -9.3, -1.5, 0, 0.5},
+			{9, 9, -11.9, 9.7, -0.4, 0.2},
+			{10, 0, -1.9, 0, 0, 0},
+			{10, 1, -6.2, 3.4, 0, 0},
+			{10, 2, -0.1, -0.2, 0, 0.1},
+			{10, 3, 1.7, 3.5, 0.2, -0.3},
+			{10, 4, -0.9, 4.8, -0.1, 0.1},
+			{10, 5, 0.6, -8.6, -0.2, -0.2},
+			{10, 6, -0.9, -0.1, 0, 0.1},
+			{10, 7, 1.9, -4.2, -0.1, 0},
+			{10, 8, 1.4, -3.4, -0.2, -0.1},
+			{10, 9, -2.4, -0.1, -0.1, 0.2},
+			{10, 10, -3.9, -8.8, 0, 0},
+			{11, 0, 3.0, 0, 0, 0},
+			{11, 1, -1.4, 0, -0.1, 0},
+			{11, 2, -2.5, 2.6, 0, 0.1},
+			{11, 3, 2.4, -0.5, 0, 0},
+			{11, 4, -0.9, -0.4, 0, 0.2},
+			{11, 5, 0.3, 0.6, -0.1, 0},
+			{11, 6, -0.7, -0.2, 0, 0},
+			{11, 7, -0.1, -1.7, 0, 0.1},
+			{11, 8, 1.4, -1.6, -0.1, 0},
+			{11, 9, -0.6, -3.0, -0.1, -0.1},
+			{11, 10, 0.2, -2.0, -0.1, 0},
+			{11, 11, 3.1, -2.6, -0.1, 0},
+			{12, 0, -2.0, 0, 0, 0},
+			{12, 1, -0.1, -1.2, 0, 0},
+			{12, 2, 0.5, 0.5, 0, 0},
+			{12, 3, 1.3, 1.3, 0, 0},
+			{12, 4, -1.2, -1.8, 0, 0},
+			{12, 5, 0.7, 0.1, 0, 0},
+			{12, 6, 0.3, 0.7, 0, 0},
+			{12, 7, 0.5, -0.1, 0, 0},
+			{12, 8, -0.2, 0.6, 0, 0.1},
+			{12, 9, -0.5, 0.2, 0, 0},
+			{12, 10, 0.1, -0.9, 0, 0},
+			{12, 11, -1.1, 0, 0, 0},
+			{12, 12, -0.3, 0.5, -0.1, -0.1},
+		},
+	},
+	{
+		name:  "WMM-2025",
+		epoch: 2025.0,
+		coefficients: []wmmCoefficient{
+			{1, 0, -29351.8, 0, 12.0, 0},
+			{1, 1, -1410.8, 4545.4, 9.7, -21.5},
+			{2, 0, -2556.6, 0, -11.6, 0},
+			{2, 1, 2951.1, -3133.6, -5.2, -27.7},
+			{2, 2, 1649.3, -815.1, -8.0, -12.1},
+			{3, 0, 1361.0, 0, -1.3, 0},
+			{3, 1, -2404.1, -56.6, -4.2, 4.0},
+			{3, 2, 1243.8, 237.5, 0.4, -0.3},
+			{3, 3, 453.6, -549.5, -15.6, -4.1},
+			{4, 0, 895.0, 0, -1.6, 0},
+			{4, 1, 799.5, 278.6, -2.4, -1.1},
+			{4, 2, 55.7, -133.9, -6.0, 4.1},
+			{4, 3, -281.1, 212.0, 5.6, 1.6},
+			{4, 4, 12.1, -375.6, -7.0, -4.4},
+			{5, 0, -233.2, 0, 0.6, 0},
+			{5, 1, 368.9, 45.4, 1.4, -0.5},
+			{5, 2, 187.2, 220.2, 0, 2.2},
+			{5, 3, -138.7, -122.9, 0.6, 0.4},
+			{5, 4, -142.0, 43.0, 2.2, 1.7},
+			{5, 5, 20.9, 106.1, 0.9, 1.9},
+			{6, 0, 64.4, 0, -0.2, 0},
+			{6, 1, 63.8, -18.4, -0.4, 0.3},
+			{6, 2, 76.9, 16.8, 0.9, -1.6},
+			{6, 3, -115.7, 48.8, 1.2, -0.4},
+			{6, 4, -40.9, -59.8, -0.9, 0.9},
+			{6, 5, 14.9, 10.9, 0.3, 0.7},
+			{6, 6, -60.7, 72.7, 0.9, 0.9},
+			{7, 0, 79.5, 0, 0, 0},
+			{7, 1, -77.0, -48.9, -0.1, 0.6},
+			{7, 2, -8.8, -14.4, -0.1, 0.5},
+			{7, 3, 59.3, -1.0, 0.5, -0.8},
+			{7, 4, 15.8, 23.4, -0.1, 0},
+			{7, 5, 2.5, -7.4, -0.8, -1.0},
+			{7, 6, -11.1, -25.1, -0.8, 0.6},
+			{7, 7, 14.2, -2.3, 0.8, -0.2},
+			{8, 0, 23.2, 0, -0.1, 0},
+			{8, 1, 10.8, 7.1, 0.2, -0.2},
+			{8, 2, -17.5, -12.6, 0, 0.5},
+			{8, 3, 2.0, 11.4, 0.5, -0.4},
+			{8, 4, -21.7, -9.7, -0.1, 0.4},
+			{8, 5, 16.9, 12.7, 0.3, -0.5},
+			{8, 6, 15.0, 0.7, 0.2, -0.6},
+			{8, 7, -16.8, -5.2, 0, 0.3},
+			{8, 8, 0.9, 3.9, 0.2, 0.2},
+			{9, 0, 4.6, 0, 0, 0},
+			{9, 1, 7.8, -24.8, -0.1, -0.3},
+			{9, 2, 3.0, 12.2, 0.1, 0.3},
+			{9, 3, -0.2, 8.3, 0.3, -0.3},
+			{9, 4, -2.5, -3.3, -0.3, 0.3},
+			{9, 5, -13.1, -5.2, 0, 0.2},
+			{9, 6, 2.4, 7.2, 0.3, -0.1},
+			{9, 7, 8.6, -0.6, -0.1, -0.2},
+			{9, 8, -8.7, 0.8, 0.1, 0.4},
+			{9, 9, -12.9, 10.0, -0.1, 0.1},
+			{10, 0, -1.3, 0, 0.1, 0},
+			{10, 1, -6.4, 3.3, 0, 0},
+			{10, 2, 0.2, 0, 0.1, 0},
+			{10, 3, 2.0, 2.4, 0.1, -0.2},
+			{10, 4, -1.0, 5.3, 0, 0.1},
+			{10, 5, -0.6, -9.1, -0.3, -0.1},
+			{10, 6, -0.9, 0.4, 0, 0.1},
+			{10, 7, 1.5, -4.2, -0.1, 0},
+			{10, 8, 0.9, -3.8, -0.1, -0.1},
+			{10, 9, -2.7, 0.9, 0, 0.2},
+			{10, 10, -3.9, -9.1, 0, 0},
+			{11, 0, 2.9, 0, 0, 0},
+			{11, 1, -1.5, 0, 0, 0},
+			{11, 2, -2.5, 2.9, 0, 0.1},
+			{11, 3, 2.4, -0.6, 0, 0},
+			{11, 4, -0.6, 0.2, 0, 0.1},
+			{11, 5, -0.1, 0.5, -0.1, 0},
+			{11, 6, -0.6, -0.3, 0, 0},
+			{11, 7, -0.1, -1.2, 0, 0.1},
+			{11, 8, 1.1, -1.7, -0.1, 0},
+			{11, 9, -1.0, -2.9, -0.1, 0},
+			{11, 10, -0.2, -1.8, -0.1, 0},
+			{11, 11, 2.6, -2.3, -0.1, 0},
+			{12, 0, -2.0, 0, 0, 0},
+			{12, 1, -0.2, -1.3, 0, 0},
+			{12, 2, 0.3, 0.7, 0, 0},
+			{12, 3, 1.2, 1.0, 0, -0.1},
+			{12, 4, -1.3, -1.4, 0, 0.1},
+			{12, 5, 0.6, 0, 0, 0},
+			{12, 6, 0.6, 0.6, 0.1, 0},
+			{12, 7, 0.5, -0.1, 0, 0},
+			{12, 8, -0.1, 0.8, 0, 0},
+			{12, 9, -0.4, 0.1, 0, 0},
+			{12, 10, -0.2, -1.0, -0.1, 0},
+			{12, 11, -1.3, 0.1, 0, 0},
+			{12, 12, -0.7, 0.2, -0.1, -0.1},
+		},
+	},
+}
+
+// MagneticDeclination returns magnetic declination (variation) in degrees at the position, altitude (metres above
+// WGS-84 ellipsoid) and time with World Magnetic Model. Declination is positive east, true bearing is magnetic bearing
+// plus declination.
+func MagneticDeclination(p Position, altitude float64, t time.Time) (float64, error) {
+	f, err := MagneticFieldAt(p, altitude, t)
+	return f.Declination, err
+}
+
+// MagneticFieldAt calculates geomagnetic field at the position, altitude (metres above WGS-84 ellipsoid) and time
+// with embedded World Magnetic Model (WMM-2020 and WMM-2025, valid from 2020.0 to 2030.0). Error is returned for time
+// outside of validity period of the models and for invalid position.
+func MagneticFieldAt(p Position, altitude float64, t time.Time) (MagneticField, error) {
+	if err := p.Validate(); err != nil {
+		return MagneticField{}, err
+	}
+	year := decimalYear(t)
+	model, ok := wmmModelAt(year)
+	if !ok {
+		return MagneticField{}, fmt.Errorf("nmea: time %s is outside of World Magnetic Model validity period", t.UTC().Format(time.RFC3339))
+	}
+	return model.field(p, altitude, year), nil
+}
+
+func wmmModelAt(year float64) (wmmModel, bool) {
+	for i, m := range wmmModels {
+		last := i == len(wmmModels)-1
+		if year >= m.epoch && (year < m.epoch+wmmValidityYears || last && year <= m.epoch+wmmValidityYears) {
+			return m, true
+		}
+	}
+	return wmmModel{}, false
+}
+
+// field calculates geomagnetic field as described in The US/UK World Magnetic Model technical report
+func (m wmmModel) field(p Position, altitude float64, year float64) MagneticField {
+	dt := year - m.epoch
+
+	// geodetic to geocentric spherical coordinates
+	e2 := WGS84Flattening * (2 - WGS84Flattening)
+	lat := toRadians(p.Latitude)
+	lon := toRadians(p.Longitude)
+	sinLat, cosLat := math.Sincos(lat)
+	rc := WGS84SemiMajorAxis / math.Sqrt(1-e2*sinLat*sinLat)
+	xy := (rc + altitude) * cosLat
+	z := (rc*(1-e2) + altitude) * sinLat
+	r := math.Hypot(xy, z)
+	latGeocentric := math.Asin(z / r)
+	sinLatGc, cosLatGc := math.Sincos(latGeocentric)
+
+	// Schmidt semi-normalized associated Legendre functions and their derivatives by geocentric latitude
+	var P, dP [wmmMaxDegree + 1][wmmMaxDegree + 1]float64
+	P[0][0] = 1
+	for n := 1; n <= wmmMaxDegree; n++ {
+		for k := 0; k <= n; k++ {
+			if n == k {
+				f := 1.0
+				if n > 1 {
+					f = math.Sqrt(1 - 1/float64(2*n))
+				}
+				P[n][k] = f * cosLatGc * P[n-1][k-1]
+				dP[n][k] = f * (cosLatGc*dP[n-1][k-1] - sinLatGc*P[n-1][k-1])
+				continue
+			}
+			nn, kk := float64(n*n), float64(k*k)
+			f1 := float64(2*n-1) / math.Sqrt(nn-kk)
+			P[n][k] = f1 * sinLatGc * P[n-1][k]
+			dP[n][k] = f1 * (sinLatGc*dP[n-1][k] + cosLatGc*P[n-1][k])
+			if n-2 >= k {
+				f2 := math.Sqrt((float64((n-1)*(n-1)) - kk) / (nn - kk))
+				P[n][k] -= f2 * P[n-2][k]
+				dP[n][k] -= f2 * dP[n-2][k]
+			}
+		}
+	}
+
+	var north, east, down float64
+	for _, c := range m.coefficients {
+		g := c.g + dt*c.gDot
+		h := c.h + dt*c.hDot
+		sinML, cosML := math.Sincos(float64(c.m) * lon)
+		ratio := math.Pow(wmmReferenceRadius/r, float64(c.n+2))
+		north -= ratio * (g*cosML + h*sinML) * dP[c.n][c.m]
+		east += ratio * float64(c.m) * (g*sinML - h*cosML) * P[c.n][c.m]
+		down -= float64(c.n+1) * ratio * (g*cosML + h*sinML) * P[c.n][c.m]
+	}
+	east /= cosLatGc
+
+	// rotate from geocentric to geodetic frame
+	sinPsi, cosPsi := math.Sincos(latGeocentric - lat)
+	result := MagneticField{
+		North: north*cosPsi - down*sinPsi,
+		East:  east,
+		Down:  north*sinPsi + down*cosPsi,
+	}
+	result.Horizontal = math.Hypot(result.North, result.East)
+	result.Total = math.Hypot(result.Horizontal, result.Down)
+	result.Declination = toDegrees(math.Atan2(result.East, result.North))
+	result.Inclination = toDegrees(math.Atan2(result.Down, result.Horizontal))
+	return result
+}
+
+// trueFromMagnetic returns true bearing (0 - 360) of magnetic bearing with magnetic declination
+func trueFromMagnetic(magnetic float64, declination float64) float64 {
+	return normalizeBearing(magnetic + declination)
+}
+
+// magneticFromTrue returns magnetic bearing (0 - 360) of true bearing with magnetic declination
+func magneticFromTrue(bearing float64, declination float64) float64 {
+	return normalizeBearing(bearing - declination)
+}
+
+// signedAngle returns angle with direction (E or W) as signed angle, positive east
+func signedAngle(value float64, direction string) float64 {
+	if direction == West {
+		return -value
+	}
+	return value
+}
+
+// decimalYear returns time as decimal year (e.g. 2022-07-02T12:00:00Z is 2022.5)
+func decimalYear(t time.Time) float64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(t.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	return float64(t.Year()) + float64(t.Sub(start))/float64(end.Sub(start))
+}
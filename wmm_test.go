@@ -0,0 +1,329 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMagneticFieldAt_WMMTestValues(t *testing.T) {
+	epoch2020 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var testCases = []magneticFieldTestCase{
+		// test values from WMM2020 technical report
+		{
+			name:     "2020.0, 0 km, 80N 0E",
+			date:     epoch2020,
+			position: Position{Latitude: 80, Longitude: 0},
+			expect:   MagneticField{North: 6570.4, East: -146.3, Down: 54606.0, Horizontal: 6572.0, Total: 55000.1, Inclination: 83.14, Declination: -1.28},
+		},
+		{
+			name:     "2020.0, 0 km, 0N 120E",
+			date:     epoch2020,
+			position: Position{Latitude: 0, Longitude: 120},
+			expect:   MagneticField{North: 39624.3, East: 109.9, Down: -10932.5, Horizontal: 39624.4, Total: 41104.9, Inclination: -15.42, Declination: 0.16},
+		},
+		{
+			name:     "2020.0, 0 km, 80S 240E",
+			date:     epoch2020,
+			position: Position{Latitude: -80, Longitude: -120},
+			expect:   MagneticField{North: 5940.6, East: 15772.1, Down: -52480.8, Horizontal: 16853.8, Total: 55120.6, Inclination: -72.20, Declination: 69.36},
+		},
+		{
+			name:     "2020.0, 100 km, 80N 0E",
+			date:     epoch2020,
+			altitude: 100000,
+			position: Position{Latitude: 80, Longitude: 0},
+			expect:   MagneticField{North: 6261.8, East: -185.5, Down: 52429.1, Horizontal: 6264.5, Total: 52802.0, Inclination: 83.19, Declination: -1.70},
+		},
+		{
+			name:     "2020.0, 100 km, 0N 120E",
+			date:     epoch2020,
+			altitude: 100000,
+			position: Position{Latitude: 0, Longitude: 120},
+			expect:   MagneticField{North: 37636.7, East: 104.9, Down: -10474.8, Horizontal: 37636.9, Total: 39067.3, Inclination: -15.55, Declination: 0.16},
+		},
+		{
+			name:     "2020.0, 100 km, 80S 240E",
+			date:     epoch2020,
+			altitude: 100000,
+			position: Position{Latitude: -80, Longitude: -120},
+			expect:   MagneticField{North: 5744.9, East: 14799.5, Down: -49969.4, Horizontal: 15875.4, Total: 52430.6, Inclination: -72.37, Declination: 68.78},
+		},
+	}
+	assertMagneticField(t, testCases)
+}
+
+func TestMagneticFieldAt_WMM2025(t *testing.T) {
+	epoch2025 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// 2027.5, middle of WMM-2025 validity period, checks secular variation
+	midEpoch2025 := time.Date(2027, 7, 2, 12, 0, 0, 0, time.UTC)
+	// Cross-check values at the test points of WMM technical reports. They are computed from WMM2025.COF
+	// coefficients with an independent implementation of the model and are NOT the test values published with the
+	// WMM2025 report.
+	var testCases = []magneticFieldTestCase{
+		{
+			name:     "2025.0, 0 km, 80N 0E",
+			date:     epoch2025,
+			position: Position{Latitude: 80, Longitude: 0},
+			expect:   MagneticField{North: 6521.6, East: 145.9, Down: 54791.5, Horizontal: 6523.2, Total: 55178.5, Inclination: 83.21, Declination: 1.28},
+		},
+		{
+			name:     "2025.0, 0 km, 0N 120E",
+			date:     epoch2025,
+			position: Position{Latitude: 0, Longitude: 120},
+			expect:   MagneticField{North: 39677.8, East: -109.6, Down: -10580.2, Horizontal: 39677.9, Total: 41064.3, Inclination: -14.93, Declination: -0.16},
+		},
+		{
+			name:     "2025.0, 0 km, 80S 240E",
+			date:     epoch2025,
+			position: Position{Latitude: -80, Longitude: -120},
+			expect:   MagneticField{North: 6117.5, East: 15751.9, Down: -52022.5, Horizontal: 16898.1, Total: 54698.2, Inclination: -72.00, Declination: 68.78},
+		},
+		{
+			name:     "2025.0, 100 km, 80N 0E",
+			date:     epoch2025,
+			altitude: 100000,
+			position: Position{Latitude: 80, Longitude: 0},
+			expect:   MagneticField{North: 6216.0, East: 92.4, Down: 52598.8, Horizontal: 6216.7, Total: 52964.9, Inclination: 83.26, Declination: 0.85},
+		},
+		{
+			name:     "2025.0, 100 km, 0N 120E",
+			date:     epoch2025,
+			altitude: 100000,
+			position: Position{Latitude: 0, Longitude: 120},
+			expect:   MagneticField{North: 37688.6, East: -96.2, Down: -10152.1, Horizontal: 37688.7, Total: 39032.1, Inclination: -15.08, Declination: -0.15},
+		},
+		{
+			name:     "2025.0, 100 km, 80S 240E",
+			date:     epoch2025,
+			altitude: 100000,
+			position: Position{Latitude: -80, Longitude: -120},
+			expect:   MagneticField{North: 5907.6, East: 14780.3, Down: -49540.7, Horizontal: 15917.1, Total: 52035.0, Inclination: -72.19, Declination: 68.21},
+		},
+		{
+			name:     "2027.5, 0 km, 80N 0E",
+			date:     midEpoch2025,
+			position: Position{Latitude: 80, Longitude: 0},
+			expect:   MagneticField{North: 6500.8, East: 294.5, Down: 54869.4, Horizontal: 6507.5, Total: 55253.9, Inclination: 83.24, Declination: 2.59},
+		},
+		{
+			name:     "2027.5, 0 km, 0N 120E",
+			date:     midEpoch2025,
+			position: Position{Latitude: 0, Longitude: 120},
+			expect:   MagneticField{North: 39701.6, East: -167.4, Down: -10381.8, Horizontal: 39702.0, Total: 41036.9, Inclination: -14.65, Declination: -0.24},
+		},
+		{
+			name:     "2027.5, 0 km, 80S 240E",
+			date:     midEpoch2025,
+			position: Position{Latitude: -80, Longitude: -120},
+			expect:   MagneticField{North: 6200.7, East: 15730.3, Down: -51783.7, Horizontal: 16908.3, Total: 54474.2, Inclination: -71.92, Declination: 68.49},
+		},
+		{
+			name:     "2027.5, 100 km, 80N 0E",
+			date:     midEpoch2025,
+			altitude: 100000,
+			position: Position{Latitude: 80, Longitude: 0},
+			expect:   MagneticField{North: 6196.7, East: 233.8, Down: 52670.5, Horizontal: 6201.1, Total: 53034.3, Inclination: 83.29, Declination: 2.16},
+		},
+		{
+			name:     "2027.5, 100 km, 0N 120E",
+			date:     midEpoch2025,
+			altitude: 100000,
+			position: Position{Latitude: 0, Longitude: 120},
+			expect:   MagneticField{North: 37711.5, East: -148.7, Down: -9969.8, Horizontal: 37711.8, Total: 39007.4, Inclination: -14.81, Declination: -0.23},
+		},
+		{
+			name:     "2027.5, 100 km, 80S 240E",
+			date:     midEpoch2025,
+			altitude: 100000,
+			position: Position{Latitude: -80, Longitude: -120},
+			expect:   MagneticField{North: 5984.0, East: 14760.1, Down: -49317.7, Horizontal: 15927.0, Total: 51825.7, Inclination: -72.10, Declination: 67.93},
+		},
+	}
+	assertMagneticField(t, testCases)
+}
+
+type magneticFieldTestCase struct {
+	name     string
+	date     time.Time
+	altitude float64
+	position Position
+	expect   MagneticField
+}
+
+func assertMagneticField(t *testing.T, testCases []magneticFieldTestCase) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := MagneticFieldAt(tc.position, tc.altitude, tc.date)
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.expect.North, f.North, 0.1)
+			assert.InDelta(t, tc.expect.East, f.East, 0.1)
+			assert.InDelta(t, tc.expect.Down, f.Down, 0.1)
+			assert.InDelta(t, tc.expect.Horizontal, f.Horizontal, 0.1)
+			assert.InDelta(t, tc.expect.Total, f.Total, 0.1)
+			assert.InDelta(t, tc.expect.Inclination, f.Inclination, 0.01)
+			assert.InDelta(t, tc.expect.Declination, f.Declination, 0.01)
+
+			d, err := MagneticDeclination(tc.position, tc.altitude, tc.date)
+			assert.NoError(t, err)
+			assert.Equal(t, f.Declination, d)
+		})
+	}
+}
+
+func TestMagneticFieldAt_ModelChange(t *testing.T) {
+	// WMM-2020 extrapolated to the end of its validity period is close to WMM-2025 at its epoch
+	p := Position{Latitude: 51.5, Longitude: -0.1}
+	wmm2020 := wmmModels[0].field(p, 0, 2025.0)
+	wmm2025, err := MagneticFieldAt(p, 0, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.InDelta(t, wmm2020.Declination, wmm2025.Declination, 0.25)
+	assert.InDelta(t, wmm2020.Total, wmm2025.Total, 100)
+
+	d, err := MagneticDeclination(p, 0, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.3, d, 0.5)
+}
+
+func TestMagneticFieldAt_Errors(t *testing.T) {
+	_, err := MagneticFieldAt(Position{}, 0, time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.EqualError(t, err, "nmea: time 2019-12-31T00:00:00Z is outside of World Magnetic Model validity period")
+
+	_, err = MagneticFieldAt(Position{}, 0, time.Date(2030, 1, 1, 0, 0, 1, 0, time.UTC))
+	assert.EqualError(t, err, "nmea: time 2030-01-01T00:00:01Z is outside of World Magnetic Model validity period")
+
+	_, err = MagneticFieldAt(Position{}, 0, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	_, err = MagneticDeclination(Position{Latitude: 91}, 0, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.EqualError(t, err, "nmea: latitude 91 is out of range [-90, 90]")
+}
+
+func TestDecimalYear(t *testing.T) {
+	assert.Equal(t, 2020.0, decimalYear(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2022.5, decimalYear(time.Date(2022, 7, 2, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2024.5, decimalYear(time.Date(2024, 7, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestMagneticConversions(t *testing.T) {
+	var testCases = []struct {
+		name   string
+		when   func() interface{}
+		expect interface{}
+	}{
+		{
+			name: "HDM to HDT",
+			when: func() interface{} {
+				return HDM{BaseSentence: BaseSentence{Talker: "HC"}, Heading: 355, MagneticValid: true}.ToHDT(7.5)
+			},
+			expect: HDT{BaseSentence: BaseSentence{Talker: "HC", Type: TypeHDT}, Heading: 2.5, True: true},
+		},
+		{
+			name: "HDT to HDM",
+			when: func() interface{} {
+				return HDT{BaseSentence: BaseSentence{Talker: "HE"}, Heading: 2.5, True: true}.ToHDM(7.5)
+			},
+			expect: HDM{BaseSentence: BaseSentence{Talker: "HE", Type: TypeHDM}, Heading: 355, MagneticValid: true},
+		},
+		{
+			name: "BOD true from magnetic",
+			when: func() interface{} {
+				return BOD{BearingMagnetic: 100, BearingMagneticType: BearingMagnetic}.FillBearings(-3)
+			},
+			expect: BOD{BearingTrue: 97, BearingTrueType: BearingTrue, BearingMagnetic: 100, BearingMagneticType: BearingMagnetic},
+		},
+		{
+			name: "BOD with both bearings is unchanged",
+			when: func() interface{} {
+				return BOD{BearingTrue: 1, BearingTrueType: BearingTrue, BearingMagnetic: 2, BearingMagneticType: BearingMagnetic}.FillBearings(-3)
+			},
+			expect: BOD{BearingTrue: 1, BearingTrueType: BearingTrue, BearingMagnetic: 2, BearingMagneticType: BearingMagnetic},
+		},
+		{
+			name:   "BWC magnetic from true",
+			when:   func() interface{} { return BWC{BearingTrue: 1, BearingTrueType: BearingTrue}.FillBearings(3) },
+			expect: BWC{BearingTrue: 1, BearingTrueType: BearingTrue, BearingMagnetic: 358, BearingMagneticType: BearingMagnetic},
+		},
+		{
+			name:   "MWD true from magnetic",
+			when:   func() interface{} { return MWD{WindDirectionMagnetic: 180, MagneticValid: true}.FillDirections(2.5) },
+			expect: MWD{WindDirectionTrue: 182.5, TrueValid: true, WindDirectionMagnetic: 180, MagneticValid: true},
+		},
+		{
+			name:   "MWD magnetic from true",
+			when:   func() interface{} { return MWD{WindDirectionTrue: 180, TrueValid: true}.FillDirections(2.5) },
+			expect: MWD{WindDirectionTrue: 180, TrueValid: true, WindDirectionMagnetic: 177.5, MagneticValid: true},
+		},
+		{
+			name:   "VTG without fields",
+			when:   func() interface{} { return VTG{TrueTrack: 10}.FillTracks(-2) },
+			expect: VTG{TrueTrack: 10, MagneticTrack: 12},
+		},
+		{
+			name:   "HDG fill variation",
+			when:   func() interface{} { return HDG{Heading: 98.3}.FillVariation(-1.5) },
+			expect: HDG{Heading: 98.3, Variation: 1.5, VariationDirection: West},
+		},
+		{
+			name: "HDG variation is kept",
+			when: func() interface{} {
+				return HDG{Heading: 98.3, Variation: 2, VariationDirection: East}.FillVariation(-1.5)
+			},
+			expect: HDG{Heading: 98.3, Variation: 2, VariationDirection: East},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.when())
+		})
+	}
+}
+
+func TestVTG_FillTracks(t *testing.T) {
+	s, err := Parse("$GPVTG,45.5,T,,M,00.5,N,1.0,K*60")
+	assert.NoError(t, err)
+	vtg := s.(VTG).FillTracks(-4.5)
+	assert.Equal(t, 45.5, vtg.TrueTrack)
+	assert.Equal(t, 50.0, vtg.MagneticTrack)
+
+	s, err = Parse("$GPVTG,,T,0,M,00.5,N,1.0,K*4A")
+	assert.NoError(t, err)
+	vtg = s.(VTG).FillTracks(1)
+	assert.Equal(t, 1.0, vtg.TrueTrack)
+	assert.Equal(t, 0.0, vtg.MagneticTrack)
+}
+
+func TestHDG_TrueHeading(t *testing.T) {
+	s, err := Parse("$HCHDG,98.3,0.0,E,12.6,W*57")
+	assert.NoError(t, err)
+	hdg := s.(HDG)
+	assert.InDelta(t, 98.3, hdg.MagneticHeading(), 0.000001)
+	assert.InDelta(t, 85.7, hdg.TrueHeading(5), 0.000001)
+
+	// declination is used when variation is empty
+	hdg = HDG{Heading: 359, Deviation: 2, DeviationDirection: East}
+	assert.InDelta(t, 1, hdg.MagneticHeading(), 0.000001)
+	assert.InDelta(t, 6, hdg.TrueHeading(5), 0.000001)
+}
+
+func TestRMC_FillVariation(t *testing.T) {
+	s, err := Parse("$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,,,A*62")
+	assert.NoError(t, err)
+	rmc := s.(RMC).FillVariation(-1.5)
+	assert.Equal(t, -1.5, rmc.Variation)
+	raw, err := Encode(rmc)
+	assert.NoError(t, err)
+	assert.Equal(t, "$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,1.5,W,A*1F", raw)
+
+	// variation of the sentence is kept, also zero variation
+	s, err = Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	assert.Equal(t, -4.2, s.(RMC).FillVariation(-1.5).Variation)
+	s, err = Parse("$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,0.0,E,A*09")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, s.(RMC).FillVariation(-1.5).Variation)
+
+	// sentence without fields
+	assert.Equal(t, 2.5, RMC{}.FillVariation(2.5).Variation)
+}
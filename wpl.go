@@ -20,7 +20,7 @@ type WPL struct {
 
 // newWPL constructor
 func newWPL(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeWPL)
 	return WPL{
 		BaseSentence: s,
@@ -29,3 +29,17 @@ func newWPL(s BaseSentence) (Sentence, error) {
 		Ident:        p.String(4, "ident of nth waypoint"),
 	}, p.Err()
 }
+
+// Position returns position of the waypoint
+func (s WPL) Position() Position {
+	return Position{Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// EncodeFields returns the WPL sentence fields in wire format
+func (s WPL) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Latitude(s.Latitude, "latitude")
+	e.Longitude(s.Longitude, "longitude")
+	e.String(s.Ident)
+	return e.Fields()
+}
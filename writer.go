@@ -0,0 +1,128 @@
+package nmea
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// MaxSentenceLength is the maximum length of sentence in characters, including start delimiter and <CR><LF>
+// terminator but excluding tag block.
+const MaxSentenceLength = 82
+
+// ErrRateLimited is returned by Writer when sentence is not written because previous sentence of the same type was
+// written less than the rate limit interval ago.
+var ErrRateLimited = errors.New("nmea: sentence is rate limited")
+
+// Writer writes sentences to io.Writer. Sentences are written with start delimiter, checksum and <CR><LF>
+// terminator, optionally prefixed with tag block.
+//
+// Writer is safe for concurrent use by multiple goroutines. Each sentence is written with single Write call to the
+// underlying writer.
+type Writer struct {
+	// Strict enables sentence length check. Sentences longer than MaxSentenceLength are not written.
+	Strict bool
+
+	// TagBlockSource is written as tag block source (s:) when not empty
+	TagBlockSource string
+	// TagBlockTime enables writing current UNIX time in seconds (c:) in tag block
+	TagBlockTime bool
+	// TagBlockLineCount enables writing line count (n:) in tag block. Line count increments from 1 to 999 and
+	// wraps around.
+	TagBlockLineCount bool
+
+	// RateLimits contains minimum interval between two sentences of the sentence type (map key). Sentences written
+	// more often are dropped and ErrRateLimited is returned.
+	RateLimits map[string]time.Duration
+
+	// Now returns current time, time.Now is used when nil
+	Now func() time.Time
+
+	mu        sync.Mutex
+	w         io.Writer
+	lineCount int64
+	lastWrite map[string]time.Time
+}
+
+// NewWriter creates Writer writing to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteSentence encodes the sentence (see Encode) and writes it. Tag block of the sentence is not written.
+func (w *Writer) WriteSentence(s Sentence) error {
+	raw, err := Encode(s)
+	if err != nil {
+		return err
+	}
+	return w.write(s.DataType(), raw)
+}
+
+// WriteFields writes sentence with given talker ID, sentence type and data fields. Start delimiter is `!` for
+// encapsulated sentence types (VDM, VDO etc) and `$` for others. Reserved characters in fields are escaped.
+func (w *Writer) WriteFields(talkerID, sentenceType string, fields []string) error {
+	raw := encodeSentence(startDelimiterForType(sentenceType), talkerID+sentenceType, fields)
+	return w.write(sentenceType, raw)
+}
+
+func (w *Writer) write(sentenceType, raw string) error {
+	if w.Strict && len(raw)+2 > MaxSentenceLength {
+		return fmt.Errorf("nmea: sentence is too long [%d > %d characters]", len(raw)+2, MaxSentenceLength)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.now()
+	interval, rateLimited := w.RateLimits[sentenceType]
+	if last, ok := w.lastWrite[sentenceType]; rateLimited && ok && now.Sub(last) < interval {
+		return ErrRateLimited
+	}
+
+	line := make([]byte, 0, len(raw)+40)
+	lineCount := w.lineCount%maxLineCount + 1
+	if w.TagBlockSource != "" || w.TagBlockTime || w.TagBlockLineCount {
+		tagBlock := TagBlock{Source: w.TagBlockSource}
+		if w.TagBlockTime {
+			tagBlock.Time = now.Unix()
+		}
+		if w.TagBlockLineCount {
+			tagBlock.LineCount = lineCount
+		}
+		line = append(line, FormatTagBlock(tagBlock)...)
+	}
+	line = append(line, raw...)
+	line = append(line, '\r', '\n')
+	if _, err := w.w.Write(line); err != nil {
+		return err
+	}
+
+	// failed writes do not use up rate limit interval or line number
+	if w.TagBlockLineCount {
+		w.lineCount = lineCount
+	}
+	if rateLimited {
+		if w.lastWrite == nil {
+			w.lastWrite = map[string]time.Time{}
+		}
+		w.lastWrite[sentenceType] = now
+	}
+	return nil
+}
+
+func (w *Writer) now() time.Time {
+	if w.Now != nil {
+		return w.Now()
+	}
+	return time.Now()
+}
+
+// startDelimiterForType returns start delimiter used for sentences of given type
+func startDelimiterForType(sentenceType string) string {
+	if info, ok := defaultMetadataRegistry.Lookup(sentenceType); ok && info.Encapsulated {
+		return SentenceStartEncapsulated
+	}
+	return SentenceStart
+}
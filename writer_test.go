@@ -0,0 +1,135 @@
+package nmea
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter_WriteSentence(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	hdt := HDT{BaseSentence: BaseSentence{Talker: "GP", Type: TypeHDT}, Heading: 123.4, True: true}
+	assert.NoError(t, w.WriteSentence(hdt))
+
+	vdm, err := Parse("!AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25")
+	assert.NoError(t, err)
+	assert.NoError(t, w.WriteSentence(vdm))
+
+	assert.Equal(t, "$GPHDT,123.4,T*31\r\n!AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25\r\n", buf.String())
+}
+
+func TestWriter_WriteFields(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	assert.NoError(t, w.WriteFields("GP", TypeTXT, []string{"01", "01", "02", "a,b"}))
+	assert.NoError(t, w.WriteFields("AI", TypeVDM, []string{"1", "1", "", "B", "13aEOK?P00PD2wVMdLDRhgvL289?", "0"}))
+	assert.Equal(t, "$GPTXT,01,01,02,a^2Cb*61\r\n!AIVDM,1,1,,B,13aEOK?P00PD2wVMdLDRhgvL289?,0*25\r\n", buf.String())
+}
+
+func TestWriter_TagBlock(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.TagBlockSource = "GP0001"
+	w.TagBlockTime = true
+	w.TagBlockLineCount = true
+	w.Now = func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	assert.NoError(t, w.WriteFields("GP", TypeHDT, []string{"123.4", "T"}))
+	assert.NoError(t, w.WriteFields("GP", TypeHDT, []string{"123.4", "T"}))
+	assert.Equal(t,
+		"\\n:1,s:GP0001,c:1609459200*67\\$GPHDT,123.4,T*31\r\n"+
+			"\\n:2,s:GP0001,c:1609459200*64\\$GPHDT,123.4,T*31\r\n",
+		buf.String(),
+	)
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\r\n") {
+		s, err := Parse(line)
+		assert.NoError(t, err)
+		assert.Equal(t, "GP0001", s.(HDT).TagBlock.Source)
+	}
+}
+
+func TestWriter_Strict(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Strict = true
+
+	assert.NoError(t, w.WriteFields("GP", TypeTXT, []string{"01", "01", "02", strings.Repeat("x", 61)}))
+	err := w.WriteFields("GP", TypeTXT, []string{"01", "01", "02", strings.Repeat("x", 62)})
+	assert.EqualError(t, err, "nmea: sentence is too long [83 > 82 characters]")
+	assert.Equal(t, 82, buf.Len())
+
+	// length is not checked when not strict
+	w.Strict = false
+	assert.NoError(t, w.WriteFields("GP", TypeTXT, []string{"01", "01", "02", strings.Repeat("x", 62)}))
+}
+
+func TestWriter_RateLimits(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.RateLimits = map[string]time.Duration{TypeHDT: time.Second}
+	w.Now = func() time.Time { return now }
+
+	assert.NoError(t, w.WriteFields("GP", TypeHDT, []string{"1", "T"}))
+	assert.Equal(t, ErrRateLimited, w.WriteFields("GP", TypeHDT, []string{"2", "T"}))
+	// other types are not limited
+	assert.NoError(t, w.WriteFields("GP", TypeHDM, []string{"3", "M"}))
+	assert.NoError(t, w.WriteFields("GP", TypeHDM, []string{"4", "M"}))
+
+	now = now.Add(time.Second)
+	assert.NoError(t, w.WriteFields("GP", TypeHDT, []string{"5", "T"}))
+
+	assert.Equal(t, "$GPHDT,1,T*2A\r\n$GPHDM,3,M*28\r\n$GPHDM,4,M*2F\r\n$GPHDT,5,T*2E\r\n", buf.String())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriter_Errors(t *testing.T) {
+	w := NewWriter(failingWriter{})
+	assert.EqualError(t, w.WriteFields("GP", TypeHDT, []string{"1", "T"}), "write failed")
+
+	err := w.WriteSentence(GGA{BaseSentence: BaseSentence{Talker: "GP", Type: TypeGGA}, Latitude: 100})
+	assert.EqualError(t, err, "nmea: GPGGA invalid latitude: latitude is not in range (-90, 90)")
+}
+
+// flakyWriter fails the first write
+type flakyWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (w *flakyWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes == 1 {
+		return 0, errors.New("write failed")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestWriter_FailedWrite(t *testing.T) {
+	var buf flakyWriter
+	w := NewWriter(&buf)
+	w.TagBlockLineCount = true
+	w.RateLimits = map[string]time.Duration{TypeHDT: time.Second}
+	w.Now = func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	assert.EqualError(t, w.WriteFields("GP", TypeHDT, []string{"1", "T"}), "write failed")
+	// failed write does not use up rate limit interval and line number
+	assert.NoError(t, w.WriteFields("GP", TypeHDT, []string{"1", "T"}))
+	assert.Equal(t, ErrRateLimited, w.WriteFields("GP", TypeHDT, []string{"2", "T"}))
+	assert.NoError(t, w.WriteFields("GP", TypeHDM, []string{"3", "M"}))
+
+	assert.Equal(t, "\\n:1*65\\$GPHDT,1,T*2A\r\n\\n:2*66\\$GPHDM,3,M*28\r\n", buf.String())
+}
@@ -103,7 +103,7 @@ type XDRMeasurement struct {
 
 // newXDR constructor
 func newXDR(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeXDR)
 
 	xdr := XDR{
@@ -167,3 +167,35 @@ func newXDR(s BaseSentence) (Sentence, error) {
 	}
 	return xdr, p.Err()
 }
+
+// Temperature returns measurement of temperature transducer. Returns false for other transducer types.
+func (m XDRMeasurement) Temperature() (Temperature, bool) {
+	return Temperature{Value: m.Value, Unit: m.Unit}, m.TransducerType == TransducerTemperatureXDR
+}
+
+// Pressure returns measurement of pressure transducer. Returns false for other transducer types.
+func (m XDRMeasurement) Pressure() (Pressure, bool) {
+	return Pressure{Value: m.Value, Unit: m.Unit}, m.TransducerType == TransducerPressureXDR
+}
+
+// Angle returns measurement of angular displacement transducer. Returns false for other transducer types.
+func (m XDRMeasurement) Angle() (Angle, bool) {
+	return Angle{Value: m.Value, Unit: m.Unit}, m.TransducerType == TransducerAngularDisplacementXDR
+}
+
+// Depth returns measurement of depth transducer. Returns false for other transducer types.
+func (m XDRMeasurement) Depth() (Depth, bool) {
+	return Depth{Value: m.Value, Unit: m.Unit}, m.TransducerType == TransducerDepthXDR
+}
+
+// EncodeFields returns the XDR sentence fields in wire format
+func (s XDR) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	for _, m := range s.Measurements {
+		e.String(m.TransducerType)
+		e.Float64(m.Value, 4)
+		e.String(m.Unit)
+		e.String(m.TransducerName)
+	}
+	return e.Fields()
+}
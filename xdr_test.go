@@ -88,3 +88,29 @@ func TestXDR(t *testing.T) {
 		})
 	}
 }
+
+func TestXDRMeasurement_Accessors(t *testing.T) {
+	temperature := XDRMeasurement{TransducerType: TransducerTemperatureXDR, Value: 23.15, Unit: UnitCelsius, TransducerName: "WTHI"}
+	v, ok := temperature.Temperature()
+	assert.True(t, ok)
+	assert.Equal(t, Temperature{Value: 23.15, Unit: UnitCelsius}, v)
+	_, ok = temperature.Pressure()
+	assert.False(t, ok)
+
+	pressure := XDRMeasurement{TransducerType: TransducerPressureXDR, Value: 1.02, Unit: UnitBars, TransducerName: "BARO"}
+	p, ok := pressure.Pressure()
+	assert.True(t, ok)
+	assert.InDelta(t, 1020, p.Hectopascals(), 0.000001)
+
+	pitch := XDRMeasurement{TransducerType: TransducerAngularDisplacementXDR, Value: -37, Unit: UnitDegrees, TransducerName: "PITCH"}
+	a, ok := pitch.Angle()
+	assert.True(t, ok)
+	assert.Equal(t, -37.0, a.Degrees())
+
+	depth := XDRMeasurement{TransducerType: TransducerDepthXDR, Value: 4.5, Unit: UnitMeters, TransducerName: "KEEL"}
+	d, ok := depth.Depth()
+	assert.True(t, ok)
+	assert.Equal(t, 4.5, d.Meters())
+	_, ok = depth.Temperature()
+	assert.False(t, ok)
+}
@@ -43,7 +43,7 @@ type XTE struct {
 
 // newXTE constructor
 func newXTE(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeXTE)
 	xte := XTE{
 		BaseSentence:             s,
@@ -58,3 +58,17 @@ func newXTE(s BaseSentence) (Sentence, error) {
 	}
 	return xte, p.Err()
 }
+
+// EncodeFields returns the XTE sentence fields in wire format
+func (s XTE) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.String(s.StatusGeneralWarning)
+	e.String(s.StatusLockWarning)
+	e.Float64(s.CrossTrackErrorMagnitude, 3)
+	e.String(s.DirectionToSteer)
+	e.String(s.CrossTrackUnits)
+	if len(s.Fields) > 5 || s.FFAMode != "" {
+		e.String(s.FFAMode)
+	}
+	return e.Fields()
+}
@@ -1,5 +1,10 @@
 package nmea
 
+import (
+	"fmt"
+	"time"
+)
+
 const (
 	// TypeZDA type for ZDA sentences
 	TypeZDA = "ZDA"
@@ -23,7 +28,7 @@ type ZDA struct {
 
 // newZDA constructor
 func newZDA(s BaseSentence) (Sentence, error) {
-	p := NewParser(s)
+	p := newParser(s)
 	p.AssertType(TypeZDA)
 	return ZDA{
 		BaseSentence:  s,
@@ -35,3 +40,63 @@ func newZDA(s BaseSentence) (Sentence, error) {
 		OffsetMinutes: p.Int64(5, "offset (minutes)"),
 	}, p.Err()
 }
+
+// Timestamp returns UTC time of the sentence. Two digit years are resolved with the reference time. When reference
+// time is set, date is corrected for GPS week rollover, see ResolveDateTime.
+func (s ZDA) Timestamp(ref time.Time) (time.Time, error) {
+	if s.Year < 100 {
+		return ResolveDateTime(ref, Date{Valid: true, DD: int(s.Day), MM: int(s.Month), YY: int(s.Year)}, s.Time)
+	}
+	if err := validateDate(int(s.Year), int(s.Month), int(s.Day)); err != nil {
+		return time.Time{}, err
+	}
+	if err := validateTime(s.Time); err != nil {
+		return time.Time{}, err
+	}
+	result := dateTimeUTC(int(s.Year), int(s.Month), int(s.Day), s.Time)
+	if !ref.IsZero() {
+		result, _ = CorrectGPSWeekRollover(result, ref.UTC())
+	}
+	return result, nil
+}
+
+// Location returns local time zone of the sentence. By NMEA 0183 local zone is added to local time to obtain UTC, so
+// zone -02:00 is UTC+02:00. Zone minutes have the same sign as zone hours.
+func (s ZDA) Location() *time.Location {
+	minutes := s.OffsetMinutes
+	if minutes < 0 {
+		minutes = -minutes
+	}
+	zone := s.OffsetHours*60 + minutes
+	if s.OffsetHours < 0 {
+		zone = s.OffsetHours*60 - minutes
+	}
+	offset := -zone
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return time.FixedZone(fmt.Sprintf("UTC%c%02d:%02d", sign, offset/60, offset%60), int(-zone*60))
+}
+
+// LocalTime returns time of the sentence in the local time zone of the sentence (see Location)
+func (s ZDA) LocalTime(ref time.Time) (time.Time, error) {
+	t, err := s.Timestamp(ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(s.Location()), nil
+}
+
+// EncodeFields returns the ZDA sentence fields in wire format
+func (s ZDA) EncodeFields() ([]string, error) {
+	e := NewFieldEncoder(s.BaseSentence)
+	e.Time(s.Time, "time")
+	e.ZeroPaddedInt64(s.Day, 2)
+	e.ZeroPaddedInt64(s.Month, 2)
+	e.ZeroPaddedInt64(s.Year, 4)
+	e.ZeroPaddedInt64(s.OffsetHours, 2)
+	e.ZeroPaddedInt64(s.OffsetMinutes, 2)
+	return e.Fields()
+}
@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -53,3 +54,86 @@ func TestZDA(t *testing.T) {
 		})
 	}
 }
+
+func TestZDA_LocalTime(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		raw          string
+		expectZone   string
+		expectOffset int
+		expectLocal  string
+	}{
+		{
+			name:         "UTC",
+			raw:          "$GPZDA,172809.456,12,07,1996,00,00*57",
+			expectZone:   "UTC+00:00",
+			expectOffset: 0,
+			expectLocal:  "1996-07-12T17:28:09.456Z",
+		},
+		{
+			name:         "negative zone is east of UTC",
+			raw:          "$GPZDA,172809.456,12,07,1996,-02,30*7B",
+			expectZone:   "UTC+02:30",
+			expectOffset: 2*3600 + 30*60,
+			expectLocal:  "1996-07-12T19:58:09.456+02:30",
+		},
+		{
+			name:         "positive zone is west of UTC, leap second",
+			raw:          "$GPZDA,235960,31,12,2016,05,00*42",
+			expectZone:   "UTC-05:00",
+			expectOffset: -5 * 3600,
+			expectLocal:  "2016-12-31T19:00:00-05:00",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.raw)
+			assert.NoError(t, err)
+			zda := s.(ZDA)
+
+			name, offset := time.Time{}.In(zda.Location()).Zone()
+			assert.Equal(t, tc.expectZone, name)
+			assert.Equal(t, tc.expectOffset, offset)
+
+			local, err := zda.LocalTime(time.Time{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectLocal, local.Format(time.RFC3339Nano))
+
+			utc, err := zda.Timestamp(time.Time{})
+			assert.NoError(t, err)
+			assert.True(t, utc.Equal(local))
+		})
+	}
+}
+
+func TestZDA_Timestamp(t *testing.T) {
+	// four digit year is used as is, reference time is only used for GPS week rollover correction
+	zda := ZDA{Time: Time{Valid: true, Hour: 12}, Day: 6, Month: 10, Year: 1999}
+	result, err := zda.Timestamp(time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(1999, 10, 6, 12, 0, 0, 0, time.UTC), result)
+
+	result, err = zda.Timestamp(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 5, 22, 12, 0, 0, 0, time.UTC), result)
+
+	// old date replayed with current time as reference is not corrected
+	zda.Year = 2012
+	result, err = zda.Timestamp(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2012, 10, 6, 12, 0, 0, 0, time.UTC), result)
+
+	// two digit year is resolved with reference time
+	zda.Year = 20
+	result, err = zda.Timestamp(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 10, 6, 12, 0, 0, 0, time.UTC), result)
+
+	zda.Month = 0
+	_, err = zda.Timestamp(time.Time{})
+	assert.EqualError(t, err, "nmea: invalid date, day 6 month 0")
+
+	zda.Year, zda.Month, zda.Day = 2023, 2, 29
+	_, err = zda.Timestamp(time.Time{})
+	assert.EqualError(t, err, "nmea: invalid date, day 29 month 2 year 2023")
+}